@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strconv"
 
 	"mkuznets.com/go/form3/models"
 )
@@ -15,8 +14,53 @@ type AccountsClient interface {
 	Create(ctx context.Context, attributes *models.AccountAttributes) (*models.AccountResource, error)
 	// Fetch a single Account resource using the resource ID.
 	Fetch(ctx context.Context, id string) (*models.AccountResource, error)
+	// Update an Account resource using the resource ID and the current version number. Only non-empty attributes are modified.
+	// Returns VersionConflictError if the version is not the current one.
+	Update(ctx context.Context, id string, version int, attributes *models.AccountAttributes) (*models.AccountResource, error)
 	// Delete an Account resource using the resource ID and the current version number.
 	Delete(ctx context.Context, id string, version int) error
+	// List a single page of Account resources matching an optional filter.
+	List(ctx context.Context, filter *AccountFilter, opts *ListOptions) (*Page[*models.AccountResource], error)
+	// Iter returns an Iterator over all Account resources matching an optional filter, starting from the page given in opts. Pages are fetched lazily.
+	Iter(ctx context.Context, filter *AccountFilter, opts *ListOptions) *Iterator[*models.AccountResource]
+}
+
+// AccountFilter restricts the Account resources returned by list endpoints. Empty fields are ignored.
+type AccountFilter struct {
+	BankID        string
+	BankIDCode    string
+	AccountNumber string
+	Iban          string
+	CustomerID    string
+	Country       string
+}
+
+func (f *AccountFilter) validate() error {
+	switch {
+	case f.BankID != "" && f.BankIDCode == "":
+		return fmt.Errorf("%w: invalid account filter: filter[bank_id] requires filter[bank_id_code]", ErrValidation)
+	case f.AccountNumber != "" && f.BankID == "":
+		return fmt.Errorf("%w: invalid account filter: filter[account_number] requires filter[bank_id]", ErrValidation)
+	}
+	return nil
+}
+
+func (f *AccountFilter) queryParams() (url.Values, error) {
+	if f == nil {
+		return url.Values{}, nil
+	}
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+
+	return filterParams(map[string]string{
+		"bank_id":        f.BankID,
+		"bank_id_code":   f.BankIDCode,
+		"account_number": f.AccountNumber,
+		"iban":           f.Iban,
+		"customer_id":    f.CustomerID,
+		"country":        f.Country,
+	}), nil
 }
 
 type accountsClient struct {
@@ -25,54 +69,44 @@ type accountsClient struct {
 
 func (s *accountsClient) Create(ctx context.Context, attributes *models.AccountAttributes) (*models.AccountResource, error) {
 	request := &models.AccountResource{
-		Resource: models.Resource{
-			ID:             s.c.uuidProvider(),
-			OrganisationId: s.c.organisationId,
-			Type:           "accounts",
-		},
+		Resource:   s.c.newResource("accounts"),
 		Attributes: attributes,
 	}
-	response := &models.AccountResource{}
+	return createResource[models.AccountResource](ctx, s.c, "/v1/organisation/accounts", request.ID, request)
+}
 
-	call := &Call{
-		Method:   "POST",
-		Path:     "/v1/organisation/accounts",
-		Request:  request,
-		Response: response,
-	}
-	err := s.c.Api().Do(ctx, call)
+func (s *accountsClient) Fetch(ctx context.Context, id string) (*models.AccountResource, error) {
+	return fetchResource[models.AccountResource](ctx, s.c, fmt.Sprintf("/v1/organisation/accounts/%s", id))
+}
 
-	switch e := err.(type) {
-	case nil:
-		return response, nil
-	case Error:
-		if e.Type() == ErrorConflict {
-			return s.Fetch(ctx, request.ID)
-		}
+func (s *accountsClient) Update(ctx context.Context, id string, version int, attributes *models.AccountAttributes) (*models.AccountResource, error) {
+	request := &models.AccountResource{
+		Resource:   s.c.versionedResource("accounts", id, version),
+		Attributes: attributes,
 	}
+	return updateResource[models.AccountResource](ctx, s.c, fmt.Sprintf("/v1/organisation/accounts/%s", id), id, version, request)
+}
 
-	return nil, err
+func (s *accountsClient) Delete(ctx context.Context, id string, accountVersion int) error {
+	return deleteResource(ctx, s.c, fmt.Sprintf("/v1/organisation/accounts/%s", id), accountVersion)
 }
 
-func (s *accountsClient) Fetch(ctx context.Context, id string) (*models.AccountResource, error) {
-	response := &models.AccountResource{}
-	call := &Call{
-		Method:   "GET",
-		Path:     fmt.Sprintf("/v1/organisation/accounts/%s", id),
-		Response: response,
-	}
-	if err := s.c.Api().Do(ctx, call); err != nil {
+func (s *accountsClient) List(ctx context.Context, filter *AccountFilter, opts *ListOptions) (*Page[*models.AccountResource], error) {
+	params, err := listParams(filter, opts)
+	if err != nil {
 		return nil, err
 	}
-	return response, nil
+	return s.list(ctx, params)
 }
 
-func (s *accountsClient) Delete(ctx context.Context, id string, accountVersion int) error {
-	call := &Call{
-		Method:      "DELETE",
-		Path:        fmt.Sprintf("/v1/organisation/accounts/%s", id),
-		QueryParams: url.Values{},
+func (s *accountsClient) Iter(ctx context.Context, filter *AccountFilter, opts *ListOptions) *Iterator[*models.AccountResource] {
+	params, err := listParams(filter, opts)
+	if err != nil {
+		return newFailedIterator[*models.AccountResource](err)
 	}
-	call.QueryParams.Add("version", strconv.Itoa(accountVersion))
-	return s.c.Api().Do(ctx, call)
+	return newIterator(ctx, params, s.list)
+}
+
+func (s *accountsClient) list(ctx context.Context, params url.Values) (*Page[*models.AccountResource], error) {
+	return listPage[*models.AccountResource](ctx, s.c, "/v1/organisation/accounts", params)
 }
@@ -2,12 +2,14 @@ package form3_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"mkuznets.com/go/form3"
+	"mkuznets.com/go/form3/form3test"
 	"mkuznets.com/go/form3/internal/testutils"
 	"mkuznets.com/go/form3/models"
 )
@@ -30,19 +32,20 @@ func newAccount(ctx context.Context, client *form3.Client) (*models.AccountResou
 	})
 }
 
-func newClient(resourceId string) *form3.Client {
+func newClient(cassette *form3test.Cassette, resourceId string) *form3.Client {
 	return form3.New().
 		SetBaseUrl(testutils.BaseUrl()).
 		SetOrganisationId(organisationId).
-		SetUuidProvider(func() string { return resourceId })
+		SetUuidProvider(func() string { return resourceId }).
+		SetHttpClient(&http.Client{Transport: cassette, Timeout: form3.DefaultHttpTimeout})
 }
 
 func TestIntegration_accountsClient_Create(t *testing.T) {
-	testutils.EnsureIntegration(t)
+	cassette := testutils.EnsureIntegration(t)
 
 	t.Run("success", func(t *testing.T) {
-		resourceId := uuid.NewString()
-		client := newClient(resourceId)
+		resourceId := cassette.ID(uuid.NewString())
+		client := newClient(cassette, resourceId)
 
 		resp, err := newAccount(context.Background(), client)
 		require.NoError(t, err)
@@ -61,8 +64,8 @@ func TestIntegration_accountsClient_Create(t *testing.T) {
 	})
 
 	t.Run("success ID conflict", func(t *testing.T) {
-		resourceId := uuid.NewString()
-		client := newClient(resourceId)
+		resourceId := cassette.ID(uuid.NewString())
+		client := newClient(cassette, resourceId)
 
 		_, err := newAccount(context.Background(), client)
 		require.NoError(t, err)
@@ -76,24 +79,26 @@ func TestIntegration_accountsClient_Create(t *testing.T) {
 	})
 
 	t.Run("bad request", func(t *testing.T) {
-		client := newClient(uuid.NewString())
+		client := newClient(cassette, cassette.ID(uuid.NewString()))
 
 		attrs := &models.AccountAttributes{}
 		_, err := client.Accounts().Create(context.Background(), attrs)
-		require.ErrorAs(t, err, &form3.Error{})
-		e := err.(form3.Error)
+		var e form3.Error
+		require.ErrorAs(t, err, &e)
 		assert.Contains(t, e.Error(), "HTTP 400: validation failure")
 		assert.Equal(t, 400, e.StatusCode)
 		assert.Equal(t, form3.ErrorClientError, e.Type())
+		assert.ErrorIs(t, err, form3.ErrValidation)
+		assert.NotEmpty(t, e.FieldErrors())
 	})
 }
 
 func TestIntegration_accountsClient_Fetch(t *testing.T) {
-	testutils.EnsureIntegration(t)
+	cassette := testutils.EnsureIntegration(t)
 
 	t.Run("success", func(t *testing.T) {
-		resourceId := uuid.NewString()
-		client := newClient(resourceId)
+		resourceId := cassette.ID(uuid.NewString())
+		client := newClient(cassette, resourceId)
 		_, err := newAccount(context.Background(), client)
 		require.NoError(t, err)
 
@@ -114,24 +119,24 @@ func TestIntegration_accountsClient_Fetch(t *testing.T) {
 	})
 
 	t.Run("not found", func(t *testing.T) {
-		client := newClient("")
-		_, err := client.Accounts().Fetch(context.Background(), uuid.NewString())
+		client := newClient(cassette, "")
+		_, err := client.Accounts().Fetch(context.Background(), cassette.ID(uuid.NewString()))
 		require.ErrorContains(t, err, "HTTP 404")
 	})
 
 	t.Run("bad request", func(t *testing.T) {
-		client := newClient("")
+		client := newClient(cassette, "")
 		_, err := client.Accounts().Fetch(context.Background(), "123")
 		require.ErrorContains(t, err, "HTTP 400: id is not a valid uuid")
 	})
 }
 
 func TestIntegration_accountsClient_Delete(t *testing.T) {
-	testutils.EnsureIntegration(t)
+	cassette := testutils.EnsureIntegration(t)
 
 	t.Run("success", func(t *testing.T) {
-		resourceId := uuid.NewString()
-		client := newClient(resourceId)
+		resourceId := cassette.ID(uuid.NewString())
+		client := newClient(cassette, resourceId)
 		_, err := newAccount(context.Background(), client)
 		require.NoError(t, err)
 
@@ -139,28 +144,26 @@ func TestIntegration_accountsClient_Delete(t *testing.T) {
 		require.NoError(t, err)
 
 		_, err = client.Accounts().Fetch(context.Background(), resourceId)
-		require.ErrorAs(t, err, &form3.Error{})
-		assert.Equal(t, 404, err.(form3.Error).StatusCode)
+		require.ErrorIs(t, err, form3.ErrNotFound)
 	})
 
 	t.Run("resource not found", func(t *testing.T) {
-		client := newClient(uuid.NewString())
+		client := newClient(cassette, cassette.ID(uuid.NewString()))
 		_, err := newAccount(context.Background(), client)
 		require.NoError(t, err)
 
-		err = client.Accounts().Delete(context.Background(), uuid.NewString(), 0)
-		require.ErrorAs(t, err, &form3.Error{})
-		assert.Equal(t, 404, err.(form3.Error).StatusCode)
+		err = client.Accounts().Delete(context.Background(), cassette.ID(uuid.NewString()), 0)
+		require.ErrorIs(t, err, form3.ErrNotFound)
 	})
 
 	t.Run("invalid version", func(t *testing.T) {
-		resourceId := uuid.NewString()
-		client := newClient(resourceId)
+		resourceId := cassette.ID(uuid.NewString())
+		client := newClient(cassette, resourceId)
 		_, err := newAccount(context.Background(), client)
 		require.NoError(t, err)
 
 		err = client.Accounts().Delete(context.Background(), resourceId, 123)
-		require.ErrorAs(t, err, &form3.Error{})
+		require.ErrorIs(t, err, form3.ErrConflict)
 		assert.ErrorContains(t, err, "HTTP 409: invalid version")
 	})
 }
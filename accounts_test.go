@@ -3,6 +3,7 @@ package form3 // Intentionally do not use `form3_test` to mock Api.
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,7 @@ func Test_accountsClient_Create(t *testing.T) {
 			DoFunc: func(ctx context.Context, call *Call) error {
 				assert.Equal(t, "POST", call.Method)
 				assert.Equal(t, "/v1/organisation/accounts", call.Path)
+				assert.True(t, call.Idempotent)
 				assert.IsType(t, &models.AccountResource{}, call.Response)
 				require.IsType(t, &models.AccountResource{}, call.Request)
 
@@ -112,6 +114,82 @@ func Test_accountsClient_Fetch(t *testing.T) {
 	})
 }
 
+func Test_accountsClient_Update(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				assert.Equal(t, "PATCH", call.Method)
+				assert.Equal(t, "/v1/organisation/accounts/123", call.Path)
+				assert.IsType(t, &models.AccountResource{}, call.Response)
+				require.IsType(t, &models.AccountResource{}, call.Request)
+
+				req := call.Request.(*models.AccountResource)
+				assert.Equal(t, "accounts", req.Type)
+				assert.Equal(t, "123", req.ID)
+				assert.Equal(t, "c52fb94b-a795-4c77-969a-74e2364edb28", req.OrganisationId)
+				require.NotNil(t, req.Version)
+				assert.Equal(t, 2, *req.Version)
+				assert.Equal(t, []string{"Jane Doe"}, req.Attributes.Name)
+				return nil
+			},
+		}
+		client := New().SetOrganisationId("c52fb94b-a795-4c77-969a-74e2364edb28")
+		client.api = apiMock
+
+		_, err := client.Accounts().Update(context.Background(), "123", 2, &models.AccountAttributes{
+			Name: []string{"Jane Doe"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(apiMock.calls.Do))
+	})
+
+	t.Run("version conflict", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				return CallError{
+					Method:    call.Method,
+					Path:      call.Path,
+					RequestID: "req-1",
+					Attempts:  1,
+					Err:       Error{StatusCode: http.StatusConflict, ResponseErrorMessage: "invalid version"},
+				}
+			},
+		}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Accounts().Update(context.Background(), "123", 2, &models.AccountAttributes{})
+		require.ErrorAs(t, err, &VersionConflictError{})
+		e := err.(VersionConflictError)
+		assert.Equal(t, "123", e.ID)
+		assert.Equal(t, 2, e.Version)
+
+		var callErr CallError
+		require.ErrorAs(t, err, &callErr)
+		assert.Equal(t, "PATCH", callErr.Method)
+		assert.Equal(t, "req-1", callErr.RequestID)
+
+		var apiErr Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, ErrorConflict, apiErr.Type())
+		require.Equal(t, 1, len(apiMock.calls.Do))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				return Error{StatusCode: http.StatusInternalServerError}
+			},
+		}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Accounts().Update(context.Background(), "123", 2, &models.AccountAttributes{})
+		require.ErrorAs(t, err, &Error{})
+		assert.Equal(t, ErrorServerError, err.(Error).Type())
+	})
+}
+
 func Test_accountsClient_Delete(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		apiMock := &ApiMock{
@@ -145,3 +223,88 @@ func Test_accountsClient_Delete(t *testing.T) {
 		assert.Equal(t, ErrorServerError, err.(Error).Type())
 	})
 }
+
+func Test_accountsClient_List(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				assert.Equal(t, "GET", call.Method)
+				assert.Equal(t, "/v1/organisation/accounts", call.Path)
+				assert.Equal(t, "2", call.QueryParams.Get("page[number]"))
+				assert.Equal(t, "10", call.QueryParams.Get("page[size]"))
+				assert.Nil(t, call.Request)
+				require.IsType(t, &[]*models.AccountResource{}, call.Response)
+				require.NotNil(t, call.Links)
+
+				*call.Response.(*[]*models.AccountResource) = []*models.AccountResource{{Resource: models.Resource{ID: "123"}}}
+				call.Links.Next = "/v1/organisation/accounts?page%5Bnumber%5D=3&page%5Bsize%5D=10"
+				return nil
+			},
+		}
+		client := New()
+		client.api = apiMock
+
+		page, err := client.Accounts().List(context.Background(), nil, &ListOptions{PageNumber: 2, PageSize: 10})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(apiMock.calls.Do))
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, "123", page.Items[0].ID)
+		assert.True(t, page.HasNext())
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				assert.Equal(t, url.Values{
+					"filter[bank_id]":        {"400300"},
+					"filter[bank_id_code]":   {"GBDSC"},
+					"filter[account_number]": {"41426819"},
+					"filter[country]":        {"GB"},
+					"page[size]":             {"50"},
+				}, call.QueryParams)
+				return nil
+			},
+		}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Accounts().List(context.Background(), &AccountFilter{
+			BankID:        "400300",
+			BankIDCode:    models.BankIDCodeGB,
+			AccountNumber: "41426819",
+			Country:       models.CountryGB,
+		}, &ListOptions{PageSize: 50})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(apiMock.calls.Do))
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		apiMock := &ApiMock{}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Accounts().List(context.Background(), &AccountFilter{BankID: "400300"}, nil)
+		assert.ErrorIs(t, err, ErrValidation)
+		assert.ErrorContains(t, err, "filter[bank_id] requires filter[bank_id_code]")
+
+		it := client.Accounts().Iter(context.Background(), &AccountFilter{AccountNumber: "41426819"}, nil)
+		assert.False(t, it.Next())
+		assert.ErrorContains(t, it.Err(), "filter[account_number] requires filter[bank_id]")
+
+		assert.Equal(t, 0, len(apiMock.calls.Do))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				return Error{StatusCode: http.StatusInternalServerError}
+			},
+		}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Accounts().List(context.Background(), nil, nil)
+		require.ErrorAs(t, err, &Error{})
+		assert.Equal(t, ErrorServerError, err.(Error).Type())
+	})
+}
@@ -5,11 +5,14 @@ package form3
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"mkuznets.com/go/form3/models"
 )
 
@@ -23,23 +26,68 @@ type api struct {
 	c *Client
 }
 
+// RequestIDHeader is the response header identifying the request in the Form3 logs.
+const RequestIDHeader = "X-Request-Id"
+
 func (a *api) Do(ctx context.Context, call *Call) error {
+	start := time.Now()
+	stats := &callStats{}
+	if err := a.do(ctx, call, stats); err != nil {
+		return CallError{
+			Method:    call.Method,
+			Path:      call.Path,
+			RequestID: stats.requestID,
+			Attempts:  stats.attempts,
+			Elapsed:   time.Since(start),
+			Err:       err,
+		}
+	}
+	return nil
+}
+
+// callStats collects details of the attempts made by api.do to report them in CallError.
+type callStats struct {
+	attempts  int
+	requestID string
+}
+
+func (a *api) do(ctx context.Context, call *Call, stats *callStats) error {
 	baseUrl, err := url.Parse(a.c.baseUrl)
 	if err != nil {
 		return err
 	}
 
-	request, err := call.httpRequest(ctx, baseUrl)
+	body, err := call.body()
 	if err != nil {
 		return err
 	}
 
-	resp, err := a.withRetries(func() (*http.Response, error) {
-		resp, errC := a.c.httpClient.Do(request)
-		if errC != nil {
-			return nil, errC
+	request, err := call.httpRequest(ctx, baseUrl, body)
+	if err != nil {
+		return err
+	}
+
+	reauthenticated := false
+	resp, err := a.withRetries(ctx, call, func() (*http.Response, bool, error) {
+		stats.attempts++
+		sent, resp, errS := a.send(request, body)
+		if resp != nil {
+			stats.requestID = resp.Header.Get(RequestIDHeader)
+			if call.ResponseInfo != nil {
+				*call.ResponseInfo = ResponseInfo{StatusCode: resp.StatusCode, Header: resp.Header, RequestID: stats.requestID}
+			}
+		}
+
+		// Credentials may have been revoked or expired earlier than expected: refresh them and try once more right away.
+		// Only a 401 returned by the API counts, not one returned while obtaining the credentials.
+		if resp != nil && !reauthenticated && errors.Is(errS, ErrUnauthorized) {
+			if inv, ok := a.c.authenticator.(invalidator); ok {
+				inv.Invalidate(sent)
+				reauthenticated = true
+				return resp, true, errS
+			}
 		}
-		return resp, errorFromResponse(resp)
+		return resp, false, errS
 	})
 	if err != nil {
 		return err
@@ -47,8 +95,13 @@ func (a *api) Do(ctx context.Context, call *Call) error {
 
 	defer drainBody(resp)
 
-	if call.Response != nil {
-		body := models.Body{Data: call.Response}
+	if call.Stream != nil {
+		_, err = io.Copy(call.Stream, resp.Body)
+		return err
+	}
+
+	if call.hasResponseBody() {
+		body := models.Body{Data: call.Response, Links: call.Links, Meta: call.Meta, Included: call.Included}
 		if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
 			return err
 		}
@@ -57,20 +110,134 @@ func (a *api) Do(ctx context.Context, call *Call) error {
 	return nil
 }
 
-func (a *api) withRetries(handler func() (*http.Response, error)) (*http.Response, error) {
-	for backOff := a.c.backOffProvider(); ; {
-		resp, err := handler()
-		if err != nil && shouldRetry(err) {
-			delay := backOff.NextBackOff()
-			if delay >= 0 {
-				time.Sleep(delay)
-				continue
-			} else {
+// send authenticates and sends a fresh copy of the request, limited by the attempt timeout if configured.
+// Returns the request that was actually sent.
+func (a *api) send(template *http.Request, body []byte) (*http.Request, *http.Response, error) {
+	request, err := cloneRequest(template)
+	if err != nil {
+		return nil, nil, err
+	}
+	if a.c.authenticator != nil {
+		if err := a.c.authenticator.Authenticate(request, body); err != nil {
+			return request, nil, err
+		}
+	}
+
+	parentCtx := request.Context()
+	attemptCtx, cancel := parentCtx, context.CancelFunc(func() {})
+	if a.c.attemptTimeout > 0 {
+		attemptCtx, cancel = context.WithTimeout(parentCtx, a.c.attemptTimeout)
+		request = request.WithContext(attemptCtx)
+	}
+
+	resp, err := a.c.httpClient.Do(request)
+	if err != nil {
+		cancel()
+		if parentCtx.Err() == nil && attemptCtx.Err() == context.DeadlineExceeded {
+			return request, nil, fmt.Errorf("%w: %v", ErrAttemptTimeout, err)
+		}
+		return request, nil, err
+	}
+	// The attempt context must outlive the response body, so it is cancelled once the body is closed.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+	return request, resp, errorFromResponse(resp)
+}
+
+// cloneRequest returns a deep copy of the request with a fresh body, so that every attempt sends the complete payload.
+func cloneRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
+
+// withRetries performs attempts with handler until one succeeds or is not retried. The handler also reports whether the failed
+// attempt must be retried immediately regardless of the RetryPolicy, e.g. with refreshed credentials.
+func (a *api) withRetries(ctx context.Context, call *Call, handler func() (*http.Response, bool, error)) (*http.Response, error) {
+	backOff := a.c.backOffProvider()
+	// lastErr is the error of the previous attempt, reported along with the reason retries are interrupted.
+	var lastErr error
+	for i := 0; ; i++ {
+		if cb := a.c.circuitBreaker; cb != nil {
+			if err := cb.allow(); err != nil {
+				if lastErr != nil {
+					// The circuit has been opened by the failures of previous attempts, including those of this call.
+					return nil, &interruptedError{cause: err, lastErr: lastErr}
+				}
 				return nil, err
 			}
 		}
-		return resp, err
+
+		if rl := a.c.rateLimiter; rl != nil {
+			if err := rl.wait(ctx, call); err != nil {
+				// The attempt is not made, so the probe slot possibly taken by allow must be released with a neutral outcome.
+				if cb := a.c.circuitBreaker; cb != nil {
+					cb.record(err)
+				}
+				if lastErr != nil {
+					return nil, &interruptedError{cause: err, lastErr: lastErr}
+				}
+				return nil, err
+			}
+		}
+
+		resp, retryNow, err := handler()
+		if cb := a.c.circuitBreaker; cb != nil {
+			cb.record(err)
+		}
+		if rl := a.c.rateLimiter; rl != nil && resp != nil {
+			rl.observe(call, resp.Header)
+		}
+
+		delay := backoff.Stop
+		switch {
+		case err == nil:
+		case retryNow:
+			delay = 0
+		case a.c.retryPolicy.ShouldRetry(call, i, resp, err):
+			delay = retryDelay(backOff, err)
+		}
+		for _, hook := range a.c.attemptHooks {
+			hook(ctx, &Attempt{Call: call, Index: i, Response: resp, Err: err, Delay: delay})
+		}
+
+		if err == nil || delay < 0 {
+			return resp, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, &interruptedError{cause: ctx.Err(), lastErr: err}
+		case <-timer.C:
+		}
+		lastErr = err
+	}
+}
+
+// retryDelay returns the delay before the next attempt: the one given by the BackOff, but no shorter than the one requested by the API.
+// The requested delay is capped by the time left until MaxElapsedTime if the BackOff is *backoff.ExponentialBackOff.
+func retryDelay(b BackOff, err error) time.Duration {
+	delay := b.NextBackOff()
+	e, ok := err.(Error)
+	if delay < 0 || !ok || e.RetryAfter <= delay {
+		return delay
+	}
+
+	delay = e.RetryAfter
+	if eb, ok := b.(*backoff.ExponentialBackOff); ok && eb.MaxElapsedTime > 0 {
+		if left := eb.MaxElapsedTime - eb.GetElapsedTime(); delay > left {
+			delay = left
+		}
 	}
+	return delay
 }
 
 func errorFromResponse(resp *http.Response) error {
@@ -81,6 +248,8 @@ func errorFromResponse(resp *http.Response) error {
 
 	apiErr := Error{
 		StatusCode: resp.StatusCode,
+		RetryAfter: retryAfter(resp.Header, time.Now()),
+		RateLimit:  parseRateLimit(resp.Header),
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -93,13 +262,15 @@ func errorFromResponse(resp *http.Response) error {
 	return apiErr
 }
 
-func shouldRetry(err error) bool {
-	switch e := err.(type) {
-	case Error:
-		return e.Type() == ErrorServerError || e.Type() == ErrorTooManyRequests
-	default:
-		return true
-	}
+// cancelOnClose calls cancel after closing the underlying ReadCloser.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
 }
 
 func drainBody(resp *http.Response) {
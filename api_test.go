@@ -1,15 +1,22 @@
 package form3_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"mkuznets.com/go/form3"
 	"mkuznets.com/go/form3/internal/testutils"
+	"mkuznets.com/go/form3/models"
 )
 
 func testBackOff(maxRetries int) func() form3.BackOff {
@@ -37,6 +44,80 @@ func TestApi_Do(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("GET, envelope and response info", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/resource", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(form3.RequestIDHeader, "req-123")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"data":{"id":"1"},"included":[{"id":"2"}],"links":{"self":"/v1/resource"}}`))
+		})
+		ts := httptest.NewServer(mux)
+		defer ts.Close()
+
+		api := form3.New().SetBaseUrl(ts.URL).Api()
+
+		var response struct {
+			Id string `json:"id"`
+		}
+		var included []struct {
+			Id string `json:"id"`
+		}
+		var info form3.ResponseInfo
+		var links models.Links
+		err := api.Do(context.Background(), &form3.Call{
+			Method:       "GET",
+			Path:         "/v1/resource",
+			Response:     &response,
+			Included:     &included,
+			Links:        &links,
+			ResponseInfo: &info,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1", response.Id)
+		require.Len(t, included, 1)
+		assert.Equal(t, "2", included[0].Id)
+		assert.Equal(t, "/v1/resource", links.Self)
+		assert.Equal(t, http.StatusOK, info.StatusCode)
+		assert.Equal(t, "req-123", info.RequestID)
+		assert.Equal(t, "req-123", info.Header.Get(form3.RequestIDHeader))
+	})
+
+	t.Run("GET, stream", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/reports/1", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`raw,report,data`))
+		})
+		ts := httptest.NewServer(mux)
+		defer ts.Close()
+
+		api := form3.New().SetBaseUrl(ts.URL).Api()
+
+		var buf bytes.Buffer
+		err := api.Do(context.Background(), &form3.Call{
+			Method:   "GET",
+			Path:     "/v1/reports/1",
+			Response: &struct{}{},
+			Stream:   &buf,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "raw,report,data", buf.String())
+	})
+
+	t.Run("response info of failed call", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer ts.Close()
+
+		api := form3.New().SetBaseUrl(ts.URL).Api()
+
+		var info form3.ResponseInfo
+		err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource", ResponseInfo: &info})
+		assert.ErrorIs(t, err, form3.ErrNotFound)
+		assert.Equal(t, http.StatusNotFound, info.StatusCode)
+	})
+
 	t.Run("GET, non-JSON response", func(t *testing.T) {
 		mux := http.NewServeMux()
 		mux.HandleFunc("/v1/resource", func(w http.ResponseWriter, r *http.Request) {
@@ -169,7 +250,7 @@ func TestApi_DoRetry(t *testing.T) {
 
 		api := form3.New().SetBaseUrl(ts.URL).SetBackOffProvider(testBackOff(10)).Api()
 
-		err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource"})
+		err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource", Idempotent: true})
 		assert.NoError(t, err)
 		assert.Equal(t, 4, len(handlerMock.ServeHTTPCalls()))
 	})
@@ -181,7 +262,7 @@ func TestApi_DoRetry(t *testing.T) {
 
 		api := form3.New().SetBaseUrl(ts.URL).SetBackOffProvider(testBackOff(2)).Api()
 
-		err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource"})
+		err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource", Idempotent: true})
 		assert.ErrorContains(t, err, "HTTP 500: API error message")
 		assert.Equal(t, 3, len(handlerMock.ServeHTTPCalls()))
 	})
@@ -199,7 +280,7 @@ func TestApi_DoRetry(t *testing.T) {
 
 		api := form3.New().SetBaseUrl(ts.URL).SetBackOffProvider(testBackOff(2)).Api()
 
-		err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource"})
+		err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource", Idempotent: true})
 		assert.ErrorContains(t, err, "EOF")
 		assert.Equal(t, 3, len(handlerMock.ServeHTTPCalls()))
 	})
@@ -210,8 +291,259 @@ func TestApi_DoRetry(t *testing.T) {
 		defer ts.Close()
 
 		api := form3.New().SetBaseUrl(ts.URL).SetBackOffProvider(testBackOff(2)).Api()
-		err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource"})
+		err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource", Idempotent: true})
 		assert.ErrorContains(t, err, "HTTP 400: API error message")
 		assert.Equal(t, 1, len(handlerMock.ServeHTTPCalls()))
 	})
+
+	t.Run("non-idempotent call", func(t *testing.T) {
+		handlerMock := failingHandlerMock(3, http.StatusInternalServerError)
+		ts := httptest.NewServer(handlerMock)
+		defer ts.Close()
+
+		api := form3.New().SetBaseUrl(ts.URL).SetBackOffProvider(testBackOff(2)).Api()
+		err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource"})
+		assert.ErrorContains(t, err, "HTTP 500: API error message")
+		assert.Equal(t, 1, len(handlerMock.ServeHTTPCalls()))
+	})
+
+	t.Run("request body is replayed", func(t *testing.T) {
+		var bodies []string
+		i := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			bodies = append(bodies, string(body))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, int64(len(body)), r.ContentLength)
+
+			if i < 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+			i++
+		}))
+		defer ts.Close()
+
+		api := form3.New().SetBaseUrl(ts.URL).SetBackOffProvider(testBackOff(5)).Api()
+		err := api.Do(context.Background(), &form3.Call{
+			Method:     "POST",
+			Path:       "/v1/resource",
+			Request:    map[string]string{"id": "123"},
+			Idempotent: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			`{"data":{"id":"123"}}`,
+			`{"data":{"id":"123"}}`,
+			`{"data":{"id":"123"}}`,
+		}, bodies)
+	})
+}
+
+func TestApi_DoRetryAfter(t *testing.T) {
+	throttlingServer := func(retryAfter string) *httptest.Server {
+		i := 0
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if i == 0 {
+				w.Header().Set("Retry-After", retryAfter)
+				w.WriteHeader(http.StatusTooManyRequests)
+			}
+			i++
+		}))
+	}
+
+	t.Run("waits at least Retry-After", func(t *testing.T) {
+		ts := throttlingServer("1")
+		defer ts.Close()
+
+		var delays []time.Duration
+		client := form3.New().
+			SetBaseUrl(ts.URL).
+			SetBackOffProvider(testBackOff(5)).
+			AddAttemptHook(func(ctx context.Context, attempt *form3.Attempt) {
+				delays = append(delays, attempt.Delay)
+			})
+
+		start := time.Now()
+		err := client.Api().Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+
+		require.Len(t, delays, 2)
+		assert.Equal(t, time.Second, delays[0])
+	})
+
+	t.Run("capped by MaxElapsedTime", func(t *testing.T) {
+		ts := throttlingServer("3600")
+		defer ts.Close()
+
+		var delays []time.Duration
+		client := form3.New().
+			SetBaseUrl(ts.URL).
+			SetBackOffProvider(func() form3.BackOff {
+				b := backoff.NewExponentialBackOff()
+				b.InitialInterval = time.Millisecond
+				b.MaxElapsedTime = 100 * time.Millisecond
+				b.Reset()
+				return b
+			}).
+			AddAttemptHook(func(ctx context.Context, attempt *form3.Attempt) {
+				delays = append(delays, attempt.Delay)
+			})
+
+		err := client.Api().Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+		require.NoError(t, err)
+
+		require.Len(t, delays, 2)
+		assert.LessOrEqual(t, delays[0], 100*time.Millisecond)
+		assert.Greater(t, delays[0], 50*time.Millisecond)
+	})
+
+	t.Run("error keeps rate limit", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "7")
+			w.Header().Set("X-RateLimit-Limit", "100")
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer ts.Close()
+
+		api := form3.New().SetBaseUrl(ts.URL).SetBackOffProvider(testBackOff(0)).Api()
+
+		err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+		var e form3.Error
+		require.ErrorAs(t, err, &e)
+		assert.ErrorIs(t, err, form3.ErrRateLimited)
+		assert.Equal(t, 7*time.Second, e.RetryAfter)
+		require.NotNil(t, e.RateLimit)
+		assert.Equal(t, 100, e.RateLimit.Limit)
+		assert.Equal(t, 0, e.RateLimit.Remaining)
+	})
+}
+
+func TestApi_DoCancellation(t *testing.T) {
+	t.Run("context cancelled while waiting for retry", func(t *testing.T) {
+		handlerMock := failingHandlerMock(10, http.StatusInternalServerError)
+		ts := httptest.NewServer(handlerMock)
+		defer ts.Close()
+
+		api := form3.New().
+			SetBaseUrl(ts.URL).
+			SetBackOffProvider(func() form3.BackOff { return backoff.NewConstantBackOff(time.Minute) }).
+			Api()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err := api.Do(ctx, &form3.Call{Method: "GET", Path: "/v1/resource"})
+		assert.Less(t, time.Since(start), time.Second)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorContains(t, err, "last error: HTTP 500: API error message")
+		var apiErr form3.Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+		assert.Equal(t, 1, len(handlerMock.ServeHTTPCalls()))
+	})
+
+	t.Run("attempt timeout", func(t *testing.T) {
+		var i int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&i, 1) == 1 {
+				time.Sleep(200 * time.Millisecond)
+			}
+			_, _ = w.Write([]byte(`{"data":{"id":"123"}}`))
+		}))
+		defer ts.Close()
+
+		var errs []error
+		client := form3.New().
+			SetBaseUrl(ts.URL).
+			SetBackOffProvider(testBackOff(2)).
+			SetAttemptTimeout(50 * time.Millisecond).
+			AddAttemptHook(func(ctx context.Context, attempt *form3.Attempt) {
+				errs = append(errs, attempt.Err)
+			})
+
+		var response struct {
+			Id string `json:"id"`
+		}
+		err := client.Api().Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource", Response: &response})
+		require.NoError(t, err)
+		assert.Equal(t, "123", response.Id)
+
+		require.Len(t, errs, 2)
+		assert.ErrorIs(t, errs[0], form3.ErrAttemptTimeout)
+		assert.NoError(t, errs[1])
+	})
+}
+
+func TestApi_DoCircuitBreaker(t *testing.T) {
+	handlerMock := failingHandlerMock(100, http.StatusServiceUnavailable)
+	ts := httptest.NewServer(handlerMock)
+	defer ts.Close()
+
+	cb := form3.NewCircuitBreaker().SetWindow(3).SetMinAttempts(3).SetOpenTimeout(time.Hour)
+	api := form3.New().
+		SetBaseUrl(ts.URL).
+		SetBackOffProvider(testBackOff(10)).
+		SetCircuitBreaker(cb).
+		Api()
+
+	err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+	assert.ErrorIs(t, err, form3.ErrCircuitOpen)
+	assert.Equal(t, 3, len(handlerMock.ServeHTTPCalls()))
+	assert.Equal(t, form3.CircuitOpen, cb.State())
+	var apiErr form3.Error
+	require.ErrorAs(t, err, &apiErr, "the error that opened the circuit is reported")
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	assert.ErrorContains(t, err, "HTTP 503")
+
+	err = api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+	assert.ErrorIs(t, err, form3.ErrCircuitOpen)
+	assert.False(t, errors.As(err, &form3.Error{}), "no attempt is made while the circuit is open")
+	assert.Equal(t, 3, len(handlerMock.ServeHTTPCalls()), "API must not be called while the circuit is open")
+}
+
+func TestApi_DoCallError(t *testing.T) {
+	t.Run("HTTP error", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(form3.RequestIDHeader, "req-123")
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		api := form3.New().SetBaseUrl(ts.URL).SetBackOffProvider(testBackOff(2)).Api()
+
+		err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+		var callErr form3.CallError
+		require.ErrorAs(t, err, &callErr)
+		assert.Equal(t, "GET", callErr.Method)
+		assert.Equal(t, "/v1/resource", callErr.Path)
+		assert.Equal(t, "req-123", callErr.RequestID)
+		assert.Equal(t, 3, callErr.Attempts)
+		assert.Positive(t, callErr.Elapsed)
+		assert.ErrorContains(t, err, "GET /v1/resource (request req-123) failed after 3 attempt(s)")
+
+		var apiErr form3.Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+	})
+
+	t.Run("decoding error", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("not json"))
+		}))
+		defer ts.Close()
+
+		api := form3.New().SetBaseUrl(ts.URL).Api()
+
+		err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource", Response: &struct{}{}})
+		var callErr form3.CallError
+		require.ErrorAs(t, err, &callErr)
+		assert.Equal(t, 1, callErr.Attempts)
+		assert.Empty(t, callErr.RequestID)
+		assert.ErrorContains(t, err, "GET /v1/resource failed after 1 attempt(s)")
+	})
 }
@@ -0,0 +1,45 @@
+package form3
+
+import (
+	"crypto"
+	"net/http"
+	"time"
+
+	"mkuznets.com/go/form3/internal/httpsig"
+)
+
+// Authenticator adds credentials to API requests. It is invoked before every attempt, including retries.
+type Authenticator interface {
+	// Authenticate modifies the request (usually its headers) to authenticate it. The body is the exact payload of the request.
+	Authenticate(req *http.Request, body []byte) error
+}
+
+// invalidator is implemented by Authenticators whose credentials can be refreshed after the API rejected them with HTTP 401.
+type invalidator interface {
+	// Invalidate discards the credentials used to authenticate the request.
+	Invalidate(req *http.Request)
+}
+
+// HttpSigner is an Authenticator that signs requests with HTTP message signatures required by production Form3 environments.
+// The `Authorization: Signature ...` header covers (request-target), host, date, content-length and digest.
+type HttpSigner struct {
+	keyID string
+	key   crypto.Signer
+	now   func() time.Time
+}
+
+// NewHttpSigner creates a new HttpSigner. The key must be either *rsa.PrivateKey or *ecdsa.PrivateKey.
+func NewHttpSigner(keyID string, key crypto.Signer) *HttpSigner {
+	return &HttpSigner{
+		keyID: keyID,
+		key:   key,
+		now:   time.Now,
+	}
+}
+
+// Authenticate sets the Date and Digest headers of the request and signs it.
+func (s *HttpSigner) Authenticate(req *http.Request, body []byte) error {
+	req.Header.Set("Date", s.now().UTC().Format(http.TimeFormat))
+	req.Header.Set("Digest", httpsig.Digest(body))
+	return httpsig.Sign(req, s.keyID, s.key, httpsig.DefaultHeaders)
+}
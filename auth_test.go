@@ -0,0 +1,125 @@
+package form3_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3"
+	"mkuznets.com/go/form3/internal/httpsig"
+)
+
+// verifyingHandler verifies HTTP signatures of incoming requests and records the results.
+func verifyingHandler(t *testing.T, pub crypto.PublicKey, next http.HandlerFunc) (http.HandlerFunc, *[]error) {
+	var results []error
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		keyID, err := httpsig.Verify(r, body, func(keyID string) (crypto.PublicKey, error) {
+			if keyID != "test-key" {
+				return nil, errors.New("unknown key")
+			}
+			return pub, nil
+		})
+		results = append(results, err)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "test-key", keyID)
+		assert.NotEmpty(t, r.Header.Get("Date"))
+		assert.Equal(t, httpsig.Digest(body), r.Header.Get("Digest"))
+		next(w, r)
+	}, &results
+}
+
+func TestHttpSigner(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	keys := map[string]crypto.Signer{"rsa": rsaKey, "ecdsa": ecdsaKey}
+	for name, key := range keys {
+		t.Run(name, func(t *testing.T) {
+			handler, results := verifyingHandler(t, key.Public(), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			ts := httptest.NewServer(handler)
+			defer ts.Close()
+
+			api := form3.New().
+				SetBaseUrl(ts.URL).
+				SetAuthenticator(form3.NewHttpSigner("test-key", key)).
+				Api()
+
+			err := api.Do(context.Background(), &form3.Call{
+				Method:  "POST",
+				Path:    "/v1/resource",
+				Request: struct{ Id string }{Id: "123"},
+			})
+			require.NoError(t, err)
+
+			err = api.Do(context.Background(), &form3.Call{
+				Method:      "GET",
+				Path:        "/v1/resource",
+				QueryParams: map[string][]string{"page[number]": {"1"}},
+			})
+			require.NoError(t, err)
+
+			require.Len(t, *results, 2)
+			assert.NoError(t, (*results)[0])
+			assert.NoError(t, (*results)[1])
+		})
+	}
+
+	t.Run("wrong key", func(t *testing.T) {
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		handler, results := verifyingHandler(t, otherKey.Public(), func(w http.ResponseWriter, r *http.Request) {})
+		ts := httptest.NewServer(handler)
+		defer ts.Close()
+
+		api := form3.New().
+			SetBaseUrl(ts.URL).
+			SetAuthenticator(form3.NewHttpSigner("test-key", ecdsaKey)).
+			Api()
+
+		err = api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+		assert.ErrorContains(t, err, "HTTP 401")
+		require.Len(t, *results, 1)
+		assert.ErrorContains(t, (*results)[0], "invalid signature")
+	})
+
+	t.Run("every retry is signed", func(t *testing.T) {
+		handlerMock := failingHandlerMock(2, http.StatusServiceUnavailable)
+		handler, results := verifyingHandler(t, ecdsaKey.Public(), handlerMock.ServeHTTP)
+		ts := httptest.NewServer(handler)
+		defer ts.Close()
+
+		api := form3.New().
+			SetBaseUrl(ts.URL).
+			SetBackOffProvider(testBackOff(5)).
+			SetAuthenticator(form3.NewHttpSigner("test-key", ecdsaKey)).
+			Api()
+
+		err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+		require.NoError(t, err)
+		require.Len(t, *results, 3)
+		for _, result := range *results {
+			assert.NoError(t, result)
+		}
+	})
+}
@@ -16,6 +16,7 @@ const (
 )
 
 // BackOff is an interface for the retry policy. It is compatible with types provided by github.com/cenkalti/backoff/v4.
+// The API may request longer delays with Retry-After or X-RateLimit-* headers; those take precedence over NextBackOff.
 type BackOff interface {
 	// NextBackOff returns the duration to wait before retrying the operation.
 	// Negative duration indicates that no more retries should be made.
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/url"
 
@@ -16,12 +17,39 @@ type Call struct {
 	Method string
 	// Path is the path to the endpoint, relative to the base URL.
 	Path string
+	// Header contains additional HTTP headers to send with the request.
+	Header http.Header
 	// QueryParams is a map of query parameters to add to the request.
 	QueryParams url.Values
 	// Request is the JSON-serialisable struct to send as the request body. Should be nil for endpoints without JSON request body.
 	Request any
+	// Idempotent marks the call as safe to retry even though its HTTP method is not idempotent,
+	// e.g. POST creating a resource with a client-generated ID. GET, HEAD, OPTIONS, PUT and DELETE calls are always idempotent.
+	Idempotent bool
 	// Response is an optional pointer to a struct to unmarshal the response body into. Should be nil for endpoints without JSON response.
 	Response any
+	// Links is an optional pointer to store the links of the response envelope, such as pagination links of list endpoints.
+	Links *models.Links
+	// Meta is an optional pointer to store the metadata of the response envelope.
+	Meta *models.Meta
+	// Included is an optional pointer to unmarshal the related resources included in the response envelope into.
+	Included any
+	// Stream is an optional writer to copy the raw response body to instead of decoding it, e.g. for large reports.
+	// Response, Links, Meta and Included are ignored if Stream is set. The body is copied once the call succeeds,
+	// so a failure while copying is not retried.
+	Stream io.Writer
+	// ResponseInfo is an optional pointer to store the status and headers of the last received response, even if the call failed.
+	ResponseInfo *ResponseInfo
+}
+
+// ResponseInfo is the metadata of a raw API response.
+type ResponseInfo struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Header contains the HTTP headers of the response.
+	Header http.Header
+	// RequestID is the value of the X-Request-Id header identifying the request in the Form3 logs.
+	RequestID string
 }
 
 func (c *Call) body() ([]byte, error) {
@@ -31,21 +59,32 @@ func (c *Call) body() ([]byte, error) {
 	return json.Marshal(models.Body{Data: c.Request})
 }
 
-func (c *Call) httpRequest(ctx context.Context, baseURL *url.URL) (*http.Request, error) {
+func (c *Call) idempotent() bool {
+	switch c.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	default:
+		return c.Idempotent
+	}
+}
+
+func (c *Call) hasResponseBody() bool {
+	return c.Response != nil || c.Links != nil || c.Meta != nil || c.Included != nil
+}
+
+func (c *Call) httpRequest(ctx context.Context, baseURL *url.URL, body []byte) (*http.Request, error) {
 	u, err := baseURL.Parse(c.Path)
 	if err != nil {
 		return nil, err
 	}
 	u.RawQuery = c.QueryParams.Encode()
 
-	body, err := c.body()
+	req, err := http.NewRequestWithContext(ctx, c.Method, u.String(), bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequestWithContext(ctx, c.Method, u.String(), bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
+	for k, v := range c.Header {
+		req.Header[k] = v
 	}
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
@@ -0,0 +1,201 @@
+package form3
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling the API while the CircuitBreaker is open. If the circuit opens between retries
+// of a call, the returned error also matches the error of the last attempt with errors.Is and errors.As.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Parameters of NewCircuitBreaker.
+const (
+	CircuitBreakerWindow       = 20
+	CircuitBreakerMinAttempts  = 10
+	CircuitBreakerFailureRatio = 0.5
+	CircuitBreakerOpenTimeout  = 30 * time.Second
+)
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe request through to decide whether to close or re-open the circuit.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker stops calling the API while it is failing. It tracks outcomes of the most recent attempts and opens
+// once the share of failures (HTTP 5xx, HTTP 429, network errors and attempt timeouts) reaches the failure ratio.
+// After the open timeout, a single probe attempt is let through: the circuit closes if it succeeds and re-opens otherwise.
+//
+// A single CircuitBreaker is meant to be shared by all API calls of a Client. It is safe for concurrent use.
+type CircuitBreaker struct {
+	window       int
+	minAttempts  int
+	failureRatio float64
+	openTimeout  time.Duration
+	now          func() time.Time
+
+	mu       sync.Mutex
+	state    CircuitState
+	outcomes []bool
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker creates a new CircuitBreaker in the closed state.
+func NewCircuitBreaker() *CircuitBreaker {
+	return &CircuitBreaker{
+		window:       CircuitBreakerWindow,
+		minAttempts:  CircuitBreakerMinAttempts,
+		failureRatio: CircuitBreakerFailureRatio,
+		openTimeout:  CircuitBreakerOpenTimeout,
+		now:          time.Now,
+	}
+}
+
+// SetWindow configures the number of most recent attempts considered to compute the failure ratio.
+func (cb *CircuitBreaker) SetWindow(v int) *CircuitBreaker {
+	cb.window = v
+	return cb
+}
+
+// SetMinAttempts configures the minimum number of attempts in the window before the circuit can open.
+func (cb *CircuitBreaker) SetMinAttempts(v int) *CircuitBreaker {
+	cb.minAttempts = v
+	return cb
+}
+
+// SetFailureRatio configures the share of failed attempts in the window that opens the circuit.
+func (cb *CircuitBreaker) SetFailureRatio(v float64) *CircuitBreaker {
+	cb.failureRatio = v
+	return cb
+}
+
+// SetOpenTimeout configures how long the circuit stays open before letting a probe attempt through.
+func (cb *CircuitBreaker) SetOpenTimeout(v time.Duration) *CircuitBreaker {
+	cb.openTimeout = v
+	return cb
+}
+
+// State returns the current state of the circuit, e.g. for health checks.
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitOpen && cb.openTimeoutElapsed() {
+		return CircuitHalfOpen
+	}
+	return cb.state
+}
+
+// allow returns ErrCircuitOpen if the attempt must not be made.
+func (cb *CircuitBreaker) allow() error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.state == CircuitOpen && cb.openTimeoutElapsed() {
+		cb.state = CircuitHalfOpen
+	}
+
+	switch cb.state {
+	case CircuitOpen:
+		return ErrCircuitOpen
+	case CircuitHalfOpen:
+		if cb.probing {
+			return ErrCircuitOpen
+		}
+		cb.probing = true
+	}
+	return nil
+}
+
+// record updates the state of the circuit with the outcome of an allowed attempt.
+func (cb *CircuitBreaker) record(err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if isCircuitNeutral(err) {
+		cb.probing = false
+		return
+	}
+	failure := isCircuitFailure(err)
+
+	switch cb.state {
+	case CircuitHalfOpen:
+		cb.probing = false
+		if failure {
+			cb.open()
+		} else {
+			cb.close()
+		}
+	case CircuitClosed:
+		cb.outcomes = append(cb.outcomes, failure)
+		if failure {
+			cb.failures++
+		}
+		if len(cb.outcomes) > cb.window {
+			if cb.outcomes[0] {
+				cb.failures--
+			}
+			cb.outcomes = cb.outcomes[1:]
+		}
+		if len(cb.outcomes) >= cb.minAttempts && float64(cb.failures)/float64(len(cb.outcomes)) >= cb.failureRatio {
+			cb.open()
+		}
+	}
+}
+
+func (cb *CircuitBreaker) open() {
+	cb.state = CircuitOpen
+	cb.openedAt = cb.now()
+	cb.outcomes, cb.failures = nil, 0
+}
+
+func (cb *CircuitBreaker) close() {
+	cb.state = CircuitClosed
+	cb.outcomes, cb.failures = nil, 0
+}
+
+func (cb *CircuitBreaker) openTimeoutElapsed() bool {
+	return cb.now().Sub(cb.openedAt) >= cb.openTimeout
+}
+
+// isCircuitNeutral reports whether the outcome of the attempt says nothing about the health of the API.
+func isCircuitNeutral(err error) bool {
+	return errors.Is(err, context.Canceled) || (errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, ErrAttemptTimeout))
+}
+
+func isCircuitFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Type() == ErrorServerError || apiErr.Type() == ErrorTooManyRequests
+	}
+	var netErr net.Error
+	return errors.Is(err, ErrAttemptTimeout) || errors.As(err, &netErr)
+}
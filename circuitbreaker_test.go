@@ -0,0 +1,109 @@
+package form3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	serverError := Error{StatusCode: http.StatusInternalServerError}
+	now := time.Date(2022, 11, 9, 12, 0, 0, 0, time.UTC)
+
+	newBreaker := func() *CircuitBreaker {
+		cb := NewCircuitBreaker().SetWindow(4).SetMinAttempts(4).SetFailureRatio(0.5).SetOpenTimeout(time.Minute)
+		cb.now = func() time.Time { return now }
+		return cb
+	}
+
+	t.Run("opens when failure ratio is reached", func(t *testing.T) {
+		cb := newBreaker()
+		for _, err := range []error{nil, serverError, nil} {
+			require.NoError(t, cb.allow())
+			cb.record(err)
+		}
+		assert.Equal(t, CircuitClosed, cb.State())
+
+		require.NoError(t, cb.allow())
+		cb.record(Error{StatusCode: http.StatusTooManyRequests})
+		assert.Equal(t, CircuitOpen, cb.State())
+		assert.ErrorIs(t, cb.allow(), ErrCircuitOpen)
+	})
+
+	t.Run("only recent attempts are considered", func(t *testing.T) {
+		cb := newBreaker()
+		for _, err := range []error{serverError, nil, nil, nil, serverError, nil} {
+			require.NoError(t, cb.allow())
+			cb.record(err)
+		}
+		assert.Equal(t, CircuitClosed, cb.State())
+	})
+
+	t.Run("client errors are not failures", func(t *testing.T) {
+		cb := newBreaker()
+		for i := 0; i < 10; i++ {
+			require.NoError(t, cb.allow())
+			cb.record(Error{StatusCode: http.StatusBadRequest})
+		}
+		assert.Equal(t, CircuitClosed, cb.State())
+	})
+
+	t.Run("network errors are failures", func(t *testing.T) {
+		cb := newBreaker()
+		for i := 0; i < 4; i++ {
+			require.NoError(t, cb.allow())
+			cb.record(&url.Error{Op: "Get", URL: "/", Err: errors.New("connection refused")})
+		}
+		assert.Equal(t, CircuitOpen, cb.State())
+	})
+
+	t.Run("half-open probe closes the circuit", func(t *testing.T) {
+		cb := newBreaker()
+		cb.open()
+		assert.Equal(t, CircuitOpen, cb.State())
+
+		now = now.Add(time.Minute)
+		assert.Equal(t, CircuitHalfOpen, cb.State())
+
+		require.NoError(t, cb.allow())
+		assert.ErrorIs(t, cb.allow(), ErrCircuitOpen, "only a single probe is allowed")
+
+		cb.record(nil)
+		assert.Equal(t, CircuitClosed, cb.State())
+		assert.NoError(t, cb.allow())
+	})
+
+	t.Run("failed half-open probe re-opens the circuit", func(t *testing.T) {
+		cb := newBreaker()
+		cb.open()
+		now = now.Add(time.Minute)
+
+		require.NoError(t, cb.allow())
+		cb.record(serverError)
+		assert.Equal(t, CircuitOpen, cb.State())
+		assert.ErrorIs(t, cb.allow(), ErrCircuitOpen)
+	})
+
+	t.Run("cancelled probe keeps the circuit half-open", func(t *testing.T) {
+		cb := newBreaker()
+		cb.open()
+		now = now.Add(time.Minute)
+
+		require.NoError(t, cb.allow())
+		cb.record(&url.Error{Op: "Get", URL: "/", Err: context.Canceled})
+		assert.Equal(t, CircuitHalfOpen, cb.State())
+		assert.NoError(t, cb.allow())
+	})
+}
+
+func TestCircuitState_String(t *testing.T) {
+	assert.Equal(t, "closed", CircuitClosed.String())
+	assert.Equal(t, "open", CircuitOpen.String())
+	assert.Equal(t, "half-open", CircuitHalfOpen.String())
+}
@@ -0,0 +1,154 @@
+package form3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// ConfirmationOfPayeeClient is the Form3 API client for /v1/services/confirmation-of-payee endpoints.
+// Confirmation of Payee checks the name of the holder of a UK account with its bank before paying it for the first time.
+type ConfirmationOfPayeeClient interface {
+	// Check sends the name, the sort code and account number, and the account type of the account to its bank,
+	// and returns how the name matches the one of the account holder. AccountNumber, BankID and Name are required.
+	// Only the first entry of Name is checked, so for joint accounts it must be the name of the holder to check.
+	Check(ctx context.Context, account *models.AccountAttributes) (*PayeeMatch, error)
+}
+
+// MatchResult is the outcome of a Confirmation of Payee check.
+type MatchResult string
+
+const (
+	// MatchFull means the name matches the one of the account holder.
+	MatchFull MatchResult = "full_match"
+	// MatchClose means the name is similar to the one of the account holder, which is returned as PayeeMatch.SuggestedName.
+	MatchClose MatchResult = "close_match"
+	// MatchNone means the name does not match the one of the account holder.
+	MatchNone MatchResult = "no_match"
+	// MatchAccountTypeMismatch means the name matches, but the account is business rather than personal, or vice versa.
+	MatchAccountTypeMismatch MatchResult = "account_type_mismatch"
+	// MatchCloseAccountTypeMismatch means the name is similar, and the account is business rather than personal, or vice versa.
+	MatchCloseAccountTypeMismatch MatchResult = "close_match_account_type_mismatch"
+	// MatchAccountSwitched means the account has been switched to another bank with the Current Account Switch Service.
+	MatchAccountSwitched MatchResult = "account_switched"
+	// MatchAccountNotFound means the account does not exist.
+	MatchAccountNotFound MatchResult = "account_not_found"
+	// MatchUnavailable means the name cannot be checked, e.g. the bank does not support the account or the holder opted out.
+	MatchUnavailable MatchResult = "unavailable"
+)
+
+// PayeeMatch is the typed result of a Confirmation of Payee check.
+type PayeeMatch struct {
+	Result MatchResult
+	// SuggestedName is the name of the account holder returned for close matches, to be shown to the payer.
+	SuggestedName string
+	// ReasonCode is the reason code returned by the scheme, e.g. models.CopReasonCodeCloseMatch. Empty for full matches.
+	ReasonCode string
+}
+
+// matchResults maps reason codes of failed matches to MatchResult.
+var matchResults = map[string]MatchResult{
+	models.CopReasonCodeNoMatch:                   MatchNone,
+	models.CopReasonCodeInvalidSecondaryReference: MatchNone,
+	models.CopReasonCodeCloseMatch:                MatchClose,
+	models.CopReasonCodeBusinessNameMatch:         MatchAccountTypeMismatch,
+	models.CopReasonCodePersonalNameMatch:         MatchAccountTypeMismatch,
+	models.CopReasonCodeBusinessCloseMatch:        MatchCloseAccountTypeMismatch,
+	models.CopReasonCodePersonalCloseMatch:        MatchCloseAccountTypeMismatch,
+	models.CopReasonCodeAccountSwitched:           MatchAccountSwitched,
+	models.CopReasonCodeAccountNotFound:           MatchAccountNotFound,
+	models.CopReasonCodeAccountNotSupported:       MatchUnavailable,
+	models.CopReasonCodeOptedOut:                  MatchUnavailable,
+	models.CopReasonCodeSortCodeNotSupported:      MatchUnavailable,
+}
+
+// newPayeeMatch converts the result returned by the API. Unknown reason codes are reported as MatchUnavailable.
+func newPayeeMatch(result *models.ConfirmationOfPayeeResult) *PayeeMatch {
+	if result == nil {
+		return &PayeeMatch{Result: MatchUnavailable}
+	}
+	if result.Matched {
+		return &PayeeMatch{Result: MatchFull}
+	}
+
+	match := &PayeeMatch{Result: MatchUnavailable, ReasonCode: result.ReasonCode}
+	if r, ok := matchResults[result.ReasonCode]; ok {
+		match.Result = r
+	}
+	if match.Result == MatchClose || match.Result == MatchCloseAccountTypeMismatch {
+		match.SuggestedName = result.ActualName
+	}
+	return match
+}
+
+const confirmationOfPayeePath = "/v1/services/confirmation-of-payee"
+
+type confirmationOfPayeeClient struct {
+	c *Client
+}
+
+func validatePayee(account *models.AccountAttributes) error {
+	if account == nil {
+		return fmt.Errorf("%w: invalid payee account: account is required", ErrValidation)
+	}
+	var missing []string
+	if account.AccountNumber == "" {
+		missing = append(missing, "account_number")
+	}
+	if account.BankID == "" {
+		missing = append(missing, "bank_id")
+	}
+	if len(account.Name) == 0 || strings.TrimSpace(account.Name[0]) == "" {
+		missing = append(missing, "name")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: invalid payee account: %s required", ErrValidation, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (s *confirmationOfPayeeClient) Check(ctx context.Context, account *models.AccountAttributes) (*PayeeMatch, error) {
+	if err := validatePayee(account); err != nil {
+		return nil, err
+	}
+
+	accountType := models.AccountTypePersonal
+	if account.AccountClassification != nil && *account.AccountClassification == models.AccountClassificationBusiness {
+		accountType = models.AccountTypeBusiness
+	}
+
+	request := &models.ConfirmationOfPayeeResource{
+		Resource: s.c.newResource("confirmation_of_payee"),
+		Attributes: &models.ConfirmationOfPayeeAttributes{
+			Party: &models.ConfirmationOfPayeeParty{
+				AccountNumber:           account.AccountNumber,
+				AccountNumberCode:       models.AccountNumberCodeBBAN,
+				AccountType:             &accountType,
+				BankID:                  account.BankID,
+				BankIDCode:              account.BankIDCode,
+				Name:                    account.Name[0],
+				SecondaryIdentification: account.SecondaryIdentification,
+			},
+		},
+	}
+	response := &models.ConfirmationOfPayeeResource{}
+	call := &Call{
+		Method:   "POST",
+		Path:     confirmationOfPayeePath,
+		Request:  request,
+		Response: response,
+		// The check does not modify anything, so it is safe to retry.
+		Idempotent: true,
+	}
+	if err := s.c.Api().Do(ctx, call); err != nil {
+		return nil, err
+	}
+
+	var result *models.ConfirmationOfPayeeResult
+	if response.Attributes != nil {
+		result = response.Attributes.Result
+	}
+	return newPayeeMatch(result), nil
+}
@@ -0,0 +1,92 @@
+package form3 // Intentionally do not use `form3_test` to mock Api.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3/models"
+)
+
+func Test_confirmationOfPayeeClient_Check(t *testing.T) {
+	apiMock := &ApiMock{
+		DoFunc: func(ctx context.Context, call *Call) error {
+			assert.Equal(t, "POST", call.Method)
+			assert.Equal(t, "/v1/services/confirmation-of-payee", call.Path)
+			assert.True(t, call.Idempotent)
+			require.IsType(t, &models.ConfirmationOfPayeeResource{}, call.Request)
+
+			req := call.Request.(*models.ConfirmationOfPayeeResource)
+			assert.Equal(t, "confirmation_of_payee", req.Type)
+			assert.Equal(t, &models.ConfirmationOfPayeeParty{
+				AccountNumber:           "41426819",
+				AccountNumberCode:       models.AccountNumberCodeBBAN,
+				AccountType:             Int(models.AccountTypeBusiness),
+				BankID:                  "400300",
+				BankIDCode:              models.BankIDCodeGB,
+				Name:                    "Acme Widgets Ltd",
+				SecondaryIdentification: "ROLL-1",
+			}, req.Attributes.Party)
+
+			resp := call.Response.(*models.ConfirmationOfPayeeResource)
+			resp.Attributes = &models.ConfirmationOfPayeeAttributes{Result: &models.ConfirmationOfPayeeResult{
+				ReasonCode: models.CopReasonCodeBusinessCloseMatch,
+				ActualName: "Acme Widgets Limited",
+			}}
+			return nil
+		},
+	}
+	client := New()
+	client.api = apiMock
+
+	match, err := client.ConfirmationOfPayee().Check(context.Background(), &models.AccountAttributes{
+		AccountClassification:   String(models.AccountClassificationBusiness),
+		AccountNumber:           "41426819",
+		BankID:                  "400300",
+		BankIDCode:              models.BankIDCodeGB,
+		Name:                    []string{"Acme Widgets Ltd", "Acme Trading"},
+		SecondaryIdentification: "ROLL-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &PayeeMatch{
+		Result:        MatchCloseAccountTypeMismatch,
+		SuggestedName: "Acme Widgets Limited",
+		ReasonCode:    models.CopReasonCodeBusinessCloseMatch,
+	}, match)
+}
+
+func Test_confirmationOfPayeeClient_Check_Validation(t *testing.T) {
+	client := New()
+	client.api = &ApiMock{}
+
+	for name, account := range map[string]*models.AccountAttributes{
+		"nil account":            nil,
+		"missing account number": {BankID: "400300", Name: []string{"Jane Doe"}},
+		"missing bank ID":        {AccountNumber: "41426819", Name: []string{"Jane Doe"}},
+		"missing name":           {AccountNumber: "41426819", BankID: "400300"},
+		"empty name":             {AccountNumber: "41426819", BankID: "400300", Name: []string{" "}},
+	} {
+		_, err := client.ConfirmationOfPayee().Check(context.Background(), account)
+		assert.ErrorIs(t, err, ErrValidation, name)
+	}
+}
+
+func Test_newPayeeMatch(t *testing.T) {
+	tests := []struct {
+		result *models.ConfirmationOfPayeeResult
+		want   PayeeMatch
+	}{
+		{&models.ConfirmationOfPayeeResult{Matched: true}, PayeeMatch{Result: MatchFull}},
+		{&models.ConfirmationOfPayeeResult{ReasonCode: "ANNM", ActualName: "X"}, PayeeMatch{Result: MatchNone, ReasonCode: "ANNM"}},
+		{&models.ConfirmationOfPayeeResult{ReasonCode: "MBAM", ActualName: "X"}, PayeeMatch{Result: MatchClose, ReasonCode: "MBAM", SuggestedName: "X"}},
+		{&models.ConfirmationOfPayeeResult{ReasonCode: "PANM"}, PayeeMatch{Result: MatchAccountTypeMismatch, ReasonCode: "PANM"}},
+		{&models.ConfirmationOfPayeeResult{ReasonCode: "CASS"}, PayeeMatch{Result: MatchAccountSwitched, ReasonCode: "CASS"}},
+		{&models.ConfirmationOfPayeeResult{ReasonCode: "OPTO"}, PayeeMatch{Result: MatchUnavailable, ReasonCode: "OPTO"}},
+		{&models.ConfirmationOfPayeeResult{ReasonCode: "XXXX"}, PayeeMatch{Result: MatchUnavailable, ReasonCode: "XXXX"}},
+		{nil, PayeeMatch{Result: MatchUnavailable}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, *newPayeeMatch(tt.result))
+	}
+}
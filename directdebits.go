@@ -0,0 +1,124 @@
+package form3
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// DirectDebitsClient is the Form3 API client for /v1/transaction/directdebits endpoints.
+type DirectDebitsClient interface {
+	// Create a new direct debit collected under a mandate. The direct debit is not sent to the scheme until a submission is created.
+	Create(ctx context.Context, attributes *models.DirectDebitAttributes) (*models.DirectDebitResource, error)
+	// Fetch a single DirectDebit resource using the resource ID.
+	Fetch(ctx context.Context, id string) (*models.DirectDebitResource, error)
+	// List a single page of DirectDebit resources matching an optional filter.
+	List(ctx context.Context, filter *DirectDebitFilter, opts *ListOptions) (*Page[*models.DirectDebitResource], error)
+	// Iter returns an Iterator over all DirectDebit resources matching an optional filter, starting from the page given in opts. Pages are fetched lazily.
+	Iter(ctx context.Context, filter *DirectDebitFilter, opts *ListOptions) *Iterator[*models.DirectDebitResource]
+	// CreateSubmission submits the direct debit to the payment scheme.
+	CreateSubmission(ctx context.Context, directDebitID string) (*models.DirectDebitSubmissionResource, error)
+	// FetchSubmission fetches a single DirectDebit Submission resource using the direct debit ID and the submission ID.
+	FetchSubmission(ctx context.Context, directDebitID, submissionID string) (*models.DirectDebitSubmissionResource, error)
+	// Returns returns ReturnsClient to access returns of direct debits.
+	Returns() ReturnsClient
+	// Reversals returns ReversalsClient to access reversals of direct debits.
+	Reversals() ReversalsClient
+}
+
+// DirectDebitFilter restricts the DirectDebit resources returned by list endpoints. Empty fields are ignored.
+type DirectDebitFilter struct {
+	Currency      string
+	PaymentScheme string
+	// ProcessingDateFrom is the earliest processing date in the YYYY-MM-DD format.
+	ProcessingDateFrom string
+	// ProcessingDateTo is the latest processing date in the YYYY-MM-DD format.
+	ProcessingDateTo string
+}
+
+func (f *DirectDebitFilter) queryParams() (url.Values, error) {
+	if f == nil {
+		return url.Values{}, nil
+	}
+	if err := validateProcessingDates("direct debit", f.ProcessingDateFrom, f.ProcessingDateTo); err != nil {
+		return nil, err
+	}
+
+	return filterParams(map[string]string{
+		"currency":             f.Currency,
+		"payment_scheme":       f.PaymentScheme,
+		"processing_date_from": f.ProcessingDateFrom,
+		"processing_date_to":   f.ProcessingDateTo,
+	}), nil
+}
+
+const directDebitsPath = "/v1/transaction/directdebits"
+
+type directDebitsClient struct {
+	c         *Client
+	returns   ReturnsClient
+	reversals ReversalsClient
+}
+
+func newDirectDebitsClient(c *Client) *directDebitsClient {
+	return &directDebitsClient{
+		c:         c,
+		returns:   &returnsClient{c: c, base: directDebitsPath},
+		reversals: &reversalsClient{c: c, base: directDebitsPath},
+	}
+}
+
+func (s *directDebitsClient) Create(ctx context.Context, attributes *models.DirectDebitAttributes) (*models.DirectDebitResource, error) {
+	request := &models.DirectDebitResource{
+		Resource:   s.c.newResource("direct_debits"),
+		Attributes: attributes,
+	}
+	return createResource[models.DirectDebitResource](ctx, s.c, directDebitsPath, request.ID, request)
+}
+
+func (s *directDebitsClient) Fetch(ctx context.Context, id string) (*models.DirectDebitResource, error) {
+	return fetchResource[models.DirectDebitResource](ctx, s.c, fmt.Sprintf("%s/%s", directDebitsPath, id))
+}
+
+func (s *directDebitsClient) List(ctx context.Context, filter *DirectDebitFilter, opts *ListOptions) (*Page[*models.DirectDebitResource], error) {
+	params, err := listParams(filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.list(ctx, params)
+}
+
+func (s *directDebitsClient) Iter(ctx context.Context, filter *DirectDebitFilter, opts *ListOptions) *Iterator[*models.DirectDebitResource] {
+	params, err := listParams(filter, opts)
+	if err != nil {
+		return newFailedIterator[*models.DirectDebitResource](err)
+	}
+	return newIterator(ctx, params, s.list)
+}
+
+func (s *directDebitsClient) list(ctx context.Context, params url.Values) (*Page[*models.DirectDebitResource], error) {
+	return listPage[*models.DirectDebitResource](ctx, s.c, directDebitsPath, params)
+}
+
+func (s *directDebitsClient) CreateSubmission(ctx context.Context, directDebitID string) (*models.DirectDebitSubmissionResource, error) {
+	request := &models.DirectDebitSubmissionResource{
+		Resource: s.c.newResource("direct_debit_submissions"),
+	}
+	p := subPath(directDebitsPath, directDebitID, "submissions")
+	return createResource[models.DirectDebitSubmissionResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *directDebitsClient) FetchSubmission(ctx context.Context, directDebitID, submissionID string) (*models.DirectDebitSubmissionResource, error) {
+	p := subPath(directDebitsPath, directDebitID, "submissions", submissionID)
+	return fetchResource[models.DirectDebitSubmissionResource](ctx, s.c, p)
+}
+
+func (s *directDebitsClient) Returns() ReturnsClient {
+	return s.returns
+}
+
+func (s *directDebitsClient) Reversals() ReversalsClient {
+	return s.reversals
+}
@@ -0,0 +1,171 @@
+package form3 // Intentionally do not use `form3_test` to mock Api.
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3/models"
+)
+
+func Test_directDebitsClient(t *testing.T) {
+	runCallTests(t, []callTest{
+		{
+			name: "create direct debit",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.DirectDebits().Create(ctx, &models.DirectDebitAttributes{
+					MandateReference: models.MandateReference{Reference: "DDI-0001"},
+					Amount:           "12.50",
+					Currency:         models.CurrencyGBP,
+					PaymentScheme:    models.PaymentSchemeBacs,
+				})
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/directdebits",
+			resourceType: "direct_debits",
+			request: func(t *testing.T, request any) {
+				assert.Equal(t, "DDI-0001", request.(*models.DirectDebitResource).Attributes.Reference)
+			},
+		},
+		{
+			name: "fetch direct debit",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.DirectDebits().Fetch(ctx, "d1")
+				return err
+			},
+			method: "GET",
+			path:   "/v1/transaction/directdebits/d1",
+		},
+		{
+			name: "create direct debit submission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.DirectDebits().CreateSubmission(ctx, "d1")
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/directdebits/d1/submissions",
+			resourceType: "direct_debit_submissions",
+		},
+		{
+			name: "fetch direct debit submission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.DirectDebits().FetchSubmission(ctx, "d1", "s1")
+				return err
+			},
+			method: "GET",
+			path:   "/v1/transaction/directdebits/d1/submissions/s1",
+		},
+		{
+			name: "create direct debit return",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.DirectDebits().Returns().Create(ctx, "d1", &models.ReturnAttributes{})
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/directdebits/d1/returns",
+			resourceType: "returns",
+		},
+		{
+			name: "create direct debit reversal submission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.DirectDebits().Reversals().CreateSubmission(ctx, "d1", "r1")
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/directdebits/d1/reversals/r1/submissions",
+			resourceType: "reversal_submissions",
+		},
+		{
+			name: "create mandate",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Mandates().Create(ctx, &models.MandateAttributes{
+					MandateReference: models.MandateReference{Reference: "DDI-0001"},
+					Originator:       &models.Originator{OriginatorID: "123456"},
+					PayerAccount:     &models.PayerAccount{AccountNumber: "41426819", BankID: "400300"},
+				})
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/mandates",
+			resourceType: "mandates",
+			request: func(t *testing.T, request any) {
+				mandate := request.(*models.MandateResource)
+				assert.Equal(t, "DDI-0001", mandate.Attributes.Reference)
+				assert.Equal(t, "123456", mandate.Attributes.Originator.OriginatorID)
+			},
+		},
+		{
+			name: "fetch mandate submission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Mandates().FetchSubmission(ctx, "m1", "s1")
+				return err
+			},
+			method: "GET",
+			path:   "/v1/transaction/mandates/m1/submissions/s1",
+		},
+		{
+			name: "create mandate cancellation",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Mandates().CreateCancellation(ctx, "m1", &models.MandateCancellationAttributes{Reason: "payer request"})
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/mandates/m1/cancellations",
+			resourceType: "mandate_cancellations",
+			request: func(t *testing.T, request any) {
+				assert.Equal(t, "payer request", request.(*models.MandateCancellationResource).Attributes.Reason)
+			},
+		},
+		{
+			name: "fetch mandate cancellation",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Mandates().FetchCancellation(ctx, "m1", "c1")
+				return err
+			},
+			method: "GET",
+			path:   "/v1/transaction/mandates/m1/cancellations/c1",
+		},
+		{
+			name: "fetch mandate return",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Mandates().Returns().Fetch(ctx, "m1", "r1")
+				return err
+			},
+			method: "GET",
+			path:   "/v1/transaction/mandates/m1/returns/r1",
+		},
+	})
+}
+
+func Test_directDebitAndMandateFilters(t *testing.T) {
+	apiMock := &ApiMock{
+		DoFunc: func(ctx context.Context, call *Call) error {
+			assert.Equal(t, "GET", call.Method)
+			assert.Equal(t, "/v1/transaction/mandates", call.Path)
+			assert.Equal(t, url.Values{
+				"filter[payment_scheme]":       []string{models.PaymentSchemeBacs},
+				"filter[processing_date_from]": []string{"2022-01-01"},
+			}, call.QueryParams)
+			return nil
+		},
+	}
+	client := New()
+	client.api = apiMock
+
+	_, err := client.Mandates().List(context.Background(), &MandateFilter{
+		PaymentScheme:      models.PaymentSchemeBacs,
+		ProcessingDateFrom: "2022-01-01",
+	}, nil)
+	require.NoError(t, err)
+
+	_, err = client.DirectDebits().List(context.Background(), &DirectDebitFilter{
+		ProcessingDateFrom: "2022-02-01",
+		ProcessingDateTo:   "2022-01-01",
+	}, nil)
+	assert.True(t, errors.Is(err, ErrValidation))
+	assert.Equal(t, 1, len(apiMock.calls.Do))
+}
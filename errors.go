@@ -1,8 +1,31 @@
 package form3
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ErrAttemptTimeout is returned when a single attempt to perform an API call exceeds the timeout configured with Client.SetAttemptTimeout.
+var ErrAttemptTimeout = errors.New("attempt timed out")
+
+// Sentinel errors matched by Error with errors.Is according to the HTTP status code of the response.
+var (
+	// ErrValidation matches HTTP 400. It also wraps errors of invalid arguments detected before calling the API.
+	ErrValidation = errors.New("validation failed")
+	// ErrUnauthorized matches HTTP 401.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches HTTP 403.
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound matches HTTP 404.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict matches HTTP 409.
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited matches HTTP 429.
+	ErrRateLimited = errors.New("rate limited")
 )
 
 // ErrorType is an enumeration of possible API error types.
@@ -14,6 +37,7 @@ const (
 	ErrorTooManyRequests
 	ErrorServerError
 	ErrorUnknown
+	ErrorNotFound
 )
 
 // Error represents a Form3 API error.
@@ -21,6 +45,12 @@ type Error struct {
 	StatusCode int
 	RawBody    []byte
 
+	// RetryAfter is the delay requested by the API before the next request, either with Retry-After
+	// or with an exhausted rate limit in X-RateLimit-* headers. Zero if not requested.
+	RetryAfter time.Duration `json:"-"`
+	// RateLimit is the rate limit status returned with the response. Nil if the API did not report it.
+	RateLimit *RateLimit `json:"-"`
+
 	// Returned with HTTP 400/409
 	ResponseErrorMessage string `json:"error_message"`
 	ResponseErrorCode    string `json:"error_code"`
@@ -37,6 +67,8 @@ func (e Error) Type() ErrorType {
 		return ErrorTooManyRequests
 	case e.StatusCode == http.StatusConflict:
 		return ErrorConflict
+	case e.StatusCode == http.StatusNotFound:
+		return ErrorNotFound
 	case e.StatusCode/100 == 4:
 		return ErrorClientError
 	case e.StatusCode/100 == 5:
@@ -77,3 +109,121 @@ func (e Error) Error() string {
 	}
 	return fmt.Sprintf("%s: %s", code, msg)
 }
+
+// Is reports whether the error matches one of the sentinel errors, e.g. errors.Is(err, ErrNotFound).
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// FieldError is a validation failure of a single request field.
+type FieldError struct {
+	// Field is the name of the field, e.g. "data.attributes.country".
+	Field string
+	// Location is where the field was sent: "body", "path" or "query".
+	Location string
+	// Message describes the failure, e.g. "should match '^[A-Z]{2}$'".
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s in %s %s", e.Field, e.Location, e.Message)
+}
+
+var fieldErrorRe = regexp.MustCompile(`^(\S+) in (body|path|query) (.+)$`)
+
+// FieldErrors returns validation failures of individual fields listed in the error message of HTTP 400 responses.
+// Lines of the message that do not describe a field are skipped.
+func (e Error) FieldErrors() []FieldError {
+	var fields []FieldError
+	for _, line := range strings.Split(e.ResponseErrorMessage, "\n") {
+		if m := fieldErrorRe.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			fields = append(fields, FieldError{Field: m[1], Location: m[2], Message: m[3]})
+		}
+	}
+	return fields
+}
+
+// VersionConflictError is returned when a resource cannot be modified because the given version is not the current one.
+type VersionConflictError struct {
+	// ID is the resource ID.
+	ID string
+	// Version is the version of the resource sent with the request.
+	Version int
+	// Err is the underlying error returned by Api.Do, such as CallError wrapping the HTTP 409 Error.
+	Err error
+}
+
+func (e VersionConflictError) Error() string {
+	return fmt.Sprintf("version %d of resource %s is outdated: %s", e.Version, e.ID, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e VersionConflictError) Unwrap() error {
+	return e.Err
+}
+
+// CallError is returned by Api.Do for any failure, carrying the context of the call. The cause is available with errors.Is and errors.As.
+type CallError struct {
+	// Method is the HTTP method of the call.
+	Method string
+	// Path is the path of the call relative to the base URL.
+	Path string
+	// RequestID is the value of the X-Request-Id header of the last response. Empty if no response was received.
+	RequestID string
+	// Attempts is the number of attempts made to perform the call.
+	Attempts int
+	// Elapsed is the total time spent on the call, including delays between attempts.
+	Elapsed time.Duration
+	// Err is the cause of the failure.
+	Err error
+}
+
+func (e CallError) Error() string {
+	s := fmt.Sprintf("%s %s", e.Method, e.Path)
+	if e.RequestID != "" {
+		s += fmt.Sprintf(" (request %s)", e.RequestID)
+	}
+	return fmt.Sprintf("%s failed after %d attempt(s) in %s: %s", s, e.Attempts, e.Elapsed.Round(time.Millisecond), e.Err.Error())
+}
+
+// Unwrap returns the cause of the failure.
+func (e CallError) Unwrap() error {
+	return e.Err
+}
+
+// interruptedError is returned when retries are interrupted by a done context or an open circuit.
+// It unwraps to the cause of the interruption, but errors.Is and errors.As also match the error of the last attempt.
+type interruptedError struct {
+	cause   error
+	lastErr error
+}
+
+func (e *interruptedError) Error() string {
+	return fmt.Sprintf("%s (last error: %s)", e.cause.Error(), e.lastErr.Error())
+}
+
+func (e *interruptedError) Unwrap() error {
+	return e.cause
+}
+
+func (e *interruptedError) Is(target error) bool {
+	return errors.Is(e.lastErr, target)
+}
+
+func (e *interruptedError) As(target any) bool {
+	return errors.As(e.lastErr, target)
+}
@@ -1,9 +1,12 @@
 package form3_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"mkuznets.com/go/form3"
 )
 
@@ -60,7 +63,7 @@ func TestError_Type(t *testing.T) {
 		{
 			name: "not found",
 			err:  form3.Error{StatusCode: 404},
-			want: form3.ErrorClientError,
+			want: form3.ErrorNotFound,
 		},
 		{
 			name: "forbidden",
@@ -99,3 +102,51 @@ func TestError_Type(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionConflictError(t *testing.T) {
+	var err error = form3.VersionConflictError{
+		ID:      "123",
+		Version: 2,
+		Err:     form3.Error{StatusCode: 409, ResponseErrorMessage: "invalid version"},
+	}
+	assert.Equal(t, "version 2 of resource 123 is outdated: HTTP 409: invalid version", err.Error())
+
+	var apiErr form3.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, form3.ErrorConflict, apiErr.Type())
+}
+
+func TestError_Is(t *testing.T) {
+	sentinels := map[int]error{
+		400: form3.ErrValidation,
+		401: form3.ErrUnauthorized,
+		403: form3.ErrForbidden,
+		404: form3.ErrNotFound,
+		409: form3.ErrConflict,
+		429: form3.ErrRateLimited,
+	}
+	for code, want := range sentinels {
+		var err error = fmt.Errorf("wrapped: %w", form3.Error{StatusCode: code})
+		for _, sentinel := range sentinels {
+			assert.Equal(t, sentinel == want, errors.Is(err, sentinel), "HTTP %d is %v", code, sentinel)
+		}
+	}
+	assert.False(t, errors.Is(form3.Error{StatusCode: 500}, form3.ErrValidation))
+}
+
+func TestError_FieldErrors(t *testing.T) {
+	err := form3.Error{
+		StatusCode: 400,
+		ResponseErrorMessage: "validation failure list:\n" +
+			"validation failure list:\n" +
+			"country in body should match '^[A-Z]{2}$'\n" +
+			"id in path must be of type uuid: \"abc\"",
+	}
+	assert.Equal(t, []form3.FieldError{
+		{Field: "country", Location: "body", Message: "should match '^[A-Z]{2}$'"},
+		{Field: "id", Location: "path", Message: "must be of type uuid: \"abc\""},
+	}, err.FieldErrors())
+	assert.Equal(t, "country in body should match '^[A-Z]{2}$'", err.FieldErrors()[0].Error())
+
+	assert.Empty(t, form3.Error{StatusCode: 400, ResponseErrorMessage: "Message parsing failed"}.FieldErrors())
+}
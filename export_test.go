@@ -0,0 +1,8 @@
+package form3
+
+import "time"
+
+// SetOAuth2Clock replaces the clock of the authenticator in tests of the form3_test package.
+func SetOAuth2Clock(a *OAuth2Authenticator, now func() time.Time) {
+	a.now = now
+}
@@ -20,18 +20,40 @@ type Client struct {
 	api Api
 	// Accounts is the Form3 API client for /v1/organisation/accounts endpoints.
 	accounts AccountsClient
+	// Payments is the Form3 API client for /v1/transaction/payments endpoints.
+	payments PaymentsClient
+	// DirectDebits is the Form3 API client for /v1/transaction/directdebits endpoints.
+	directDebits DirectDebitsClient
+	// Mandates is the Form3 API client for /v1/transaction/mandates endpoints.
+	mandates MandatesClient
+	// Subscriptions is the Form3 API client for /v1/notification/subscriptions endpoints.
+	subscriptions SubscriptionsClient
+	// ConfirmationOfPayee is the Form3 API client for /v1/services/confirmation-of-payee endpoints.
+	confirmationOfPayee ConfirmationOfPayeeClient
 
 	// uuidProvider returns unique UUIDv4 identifiers used as ID of new Form3 API resources.
 	uuidProvider func() string
 	// httpClient is an instance of http.Client used for API requests.
 	httpClient      *http.Client
 	backOffProvider func() BackOff
-	baseUrl         string
-	organisationId  string
+	retryPolicy     RetryPolicy
+	circuitBreaker  *CircuitBreaker
+	rateLimiter     *RateLimiter
+	attemptTimeout  time.Duration
+	authenticator   Authenticator
+	// middlewareHead is the outermost registered middleware, and middlewareTail links the innermost one to api.
+	middlewareHead Api
+	middlewareTail *apiLink
+	attemptHooks   []AttemptHook
+	baseUrl        string
+	organisationId string
 }
 
 // Api returns Api to access artibrary Form3 API endpoints. Most users should use specialised clients (such as AccountsClient) instead.
 func (c *Client) Api() Api {
+	if c.middlewareHead != nil {
+		return c.middlewareHead
+	}
 	return c.api
 }
 
@@ -40,6 +62,31 @@ func (c *Client) Accounts() AccountsClient {
 	return c.accounts
 }
 
+// Payments returns PaymentsClient to access /v1/transaction/payments endpoints.
+func (c *Client) Payments() PaymentsClient {
+	return c.payments
+}
+
+// DirectDebits returns DirectDebitsClient to access /v1/transaction/directdebits endpoints.
+func (c *Client) DirectDebits() DirectDebitsClient {
+	return c.directDebits
+}
+
+// Mandates returns MandatesClient to access /v1/transaction/mandates endpoints.
+func (c *Client) Mandates() MandatesClient {
+	return c.mandates
+}
+
+// Subscriptions returns SubscriptionsClient to access /v1/notification/subscriptions endpoints.
+func (c *Client) Subscriptions() SubscriptionsClient {
+	return c.subscriptions
+}
+
+// ConfirmationOfPayee returns ConfirmationOfPayeeClient to access /v1/services/confirmation-of-payee endpoints.
+func (c *Client) ConfirmationOfPayee() ConfirmationOfPayeeClient {
+	return c.confirmationOfPayee
+}
+
 // New creates a new Form3 API client.
 func New() *Client {
 	client := &Client{
@@ -48,6 +95,7 @@ func New() *Client {
 			return uuid.NewString()
 		},
 		backOffProvider: DefaultBackOffProvider,
+		retryPolicy:     DefaultRetryPolicy,
 		httpClient: &http.Client{
 			Timeout: DefaultHttpTimeout,
 		},
@@ -55,6 +103,11 @@ func New() *Client {
 
 	client.api = &api{c: client}
 	client.accounts = &accountsClient{c: client}
+	client.payments = newPaymentsClient(client)
+	client.directDebits = newDirectDebitsClient(client)
+	client.mandates = newMandatesClient(client)
+	client.subscriptions = &subscriptionsClient{c: client}
+	client.confirmationOfPayee = &confirmationOfPayeeClient{c: client}
 
 	return client
 }
@@ -11,10 +11,10 @@ import (
 )
 
 func TestIntegration_Client_Api(t *testing.T) {
-	testutils.EnsureIntegration(t)
+	cassette := testutils.EnsureIntegration(t)
 
 	t.Run("invalid endpoint", func(t *testing.T) {
-		api := newClient("").Api()
+		api := newClient(cassette, "").Api()
 		err := api.Do(context.Background(), &form3.Call{
 			Method: "GET",
 			Path:   "/v1/random",
@@ -0,0 +1,281 @@
+package form3test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"mkuznets.com/go/form3/models"
+)
+
+const accountsPath = "/v1/organisation/accounts"
+
+// Pagination defaults of list endpoints.
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+type accountStore struct {
+	mu       sync.Mutex
+	accounts map[string]*models.AccountResource
+	// ids keeps the creation order of accounts, which is the order of list endpoints.
+	ids []string
+}
+
+func newAccountStore() *accountStore {
+	return &accountStore{accounts: make(map[string]*models.AccountResource)}
+}
+
+func (s *accountStore) serveCollection(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		s.create(w, r)
+	case http.MethodGet:
+		s.list(w, r)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed))
+	}
+}
+
+func (s *accountStore) serveResource(w http.ResponseWriter, r *http.Request, id string) {
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, http.StatusBadRequest, "id is not a valid uuid")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.fetch(w, id)
+	case http.MethodPatch:
+		s.update(w, r, id)
+	case http.MethodDelete:
+		s.delete(w, r, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed))
+	}
+}
+
+func (s *accountStore) create(w http.ResponseWriter, r *http.Request) {
+	account := &models.AccountResource{}
+	if err := json.NewDecoder(r.Body).Decode(&models.Body{Data: account}); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	var missing []string
+	if account.ID == "" {
+		missing = append(missing, "id")
+	}
+	if account.OrganisationId == "" {
+		missing = append(missing, "organisation_id")
+	}
+	if account.Attributes == nil {
+		missing = append(missing, "attributes")
+	} else {
+		if account.Attributes.Country == nil {
+			missing = append(missing, "country")
+		}
+		if len(account.Attributes.Name) == 0 {
+			missing = append(missing, "name")
+		}
+	}
+	if len(missing) > 0 {
+		writeError(w, http.StatusBadRequest, validationError(missing...))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.accounts[account.ID]; ok {
+		writeError(w, http.StatusConflict, "Account cannot be created as it violates a duplicate constraint")
+		return
+	}
+	version := 0
+	account.Version = &version
+	account.Type = "accounts"
+	s.accounts[account.ID] = account
+	s.ids = append(s.ids, account.ID)
+
+	writeData(w, http.StatusCreated, account)
+}
+
+func (s *accountStore) fetch(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, ok := s.accounts[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("record %s does not exist", id))
+		return
+	}
+	writeData(w, http.StatusOK, account)
+}
+
+func (s *accountStore) update(w http.ResponseWriter, r *http.Request, id string) {
+	patch := &models.AccountResource{}
+	if err := json.NewDecoder(r.Body).Decode(&models.Body{Data: patch}); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+	if patch.Version == nil {
+		writeError(w, http.StatusBadRequest, validationError("version"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, ok := s.accounts[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("record %s does not exist", id))
+		return
+	}
+	if *patch.Version != *account.Version {
+		writeError(w, http.StatusConflict, "invalid version")
+		return
+	}
+
+	attributes, err := mergeAttributes(account.Attributes, patch.Attributes)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid attributes: %v", err))
+		return
+	}
+	version := *account.Version + 1
+	updated := &models.AccountResource{Resource: account.Resource, Attributes: attributes}
+	updated.Version = &version
+	s.accounts[id] = updated
+
+	writeData(w, http.StatusOK, updated)
+}
+
+func (s *accountStore) delete(w http.ResponseWriter, r *http.Request, id string) {
+	version, err := strconv.Atoi(r.URL.Query().Get("version"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid version number")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, ok := s.accounts[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("record %s does not exist", id))
+		return
+	}
+	if version != *account.Version {
+		writeError(w, http.StatusConflict, "invalid version")
+		return
+	}
+
+	delete(s.accounts, id)
+	for i, v := range s.ids {
+		if v == id {
+			s.ids = append(s.ids[:i:i], s.ids[i+1:]...)
+			break
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *accountStore) list(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	number, size, err := pageParams(query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := checkAccountFilter(query); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	var matched []*models.AccountResource
+	for _, id := range s.ids {
+		if account := s.accounts[id]; matchesAccountFilter(account.Attributes, query) {
+			matched = append(matched, account)
+		}
+	}
+	s.mu.Unlock()
+
+	items, links := paginate(matched, accountsPath, query, number, size)
+	if items == nil {
+		items = []*models.AccountResource{}
+	}
+	writeJSON(w, http.StatusOK, models.Body{Data: items, Links: links})
+}
+
+// accountFilterFields are the fields of account list filters supported by the fake.
+var accountFilterFields = []string{"bank_id", "bank_id_code", "account_number", "iban", "country"}
+
+// checkAccountFilter rejects filters the fake does not support, e.g. filter[customer_id], rather than ignoring them.
+func checkAccountFilter(query url.Values) error {
+	for key := range query {
+		if !strings.HasPrefix(key, "filter[") {
+			continue
+		}
+		supported := false
+		for _, field := range accountFilterFields {
+			if key == fmt.Sprintf("filter[%s]", field) {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("unsupported filter %s", key)
+		}
+	}
+	return nil
+}
+
+func matchesAccountFilter(a *models.AccountAttributes, query url.Values) bool {
+	if a == nil {
+		a = &models.AccountAttributes{}
+	}
+	country := ""
+	if a.Country != nil {
+		country = *a.Country
+	}
+	values := []string{a.BankID, a.BankIDCode, a.AccountNumber, a.Iban, country}
+	for i, field := range accountFilterFields {
+		if want := query.Get(fmt.Sprintf("filter[%s]", field)); want != "" && want != values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeAttributes returns a copy of the attributes with non-empty fields of the patch applied.
+func mergeAttributes(attributes, patch *models.AccountAttributes) (*models.AccountAttributes, error) {
+	merged := map[string]json.RawMessage{}
+	for _, v := range []*models.AccountAttributes{attributes, patch} {
+		if v == nil {
+			continue
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &merged); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.AccountAttributes{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
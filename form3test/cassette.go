@@ -0,0 +1,267 @@
+package form3test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// CassetteMode is the mode of a Cassette.
+type CassetteMode int
+
+const (
+	// ModeReplay serves responses recorded earlier without calling the API.
+	ModeReplay CassetteMode = iota
+	// ModeRecord calls the API and records the interactions to be saved with Cassette.Save.
+	ModeRecord
+)
+
+// Interaction is a recorded request/response pair. IDs registered with the Cassette are replaced with placeholders.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request used for matching.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response served in the replay mode.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type cassetteFile struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Cassette is an http.RoundTripper recording real API interactions to a file and replaying them deterministically afterwards.
+// Requests are matched on method, path, query and normalised JSON body; each recorded interaction is replayed once, in order.
+//
+// Resource IDs differ between runs, so IDs generated for a test must be registered with ID or generated with UuidProvider.
+// They are stored as placeholders in the order of registration and substituted back when replaying.
+//
+// Cassettes are safe for concurrent use, but replaying is only deterministic if requests are made in the recorded order.
+type Cassette struct {
+	path string
+	mode CassetteMode
+	// Transport is used to call the API in the record mode. http.DefaultTransport is used if nil.
+	Transport http.RoundTripper
+
+	mu           sync.Mutex
+	ids          []string
+	interactions []*Interaction
+	used         []bool
+}
+
+// NewCassette creates a Cassette stored in the file at path. In the replay mode the file is loaded and must exist;
+// use errors.Is(err, fs.ErrNotExist) to detect a cassette that has not been recorded yet.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{path: path, mode: mode}
+	if mode == ModeRecord {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f cassetteFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+	}
+	c.interactions = f.Interactions
+	c.used = make([]bool, len(f.Interactions))
+	return c, nil
+}
+
+// Mode returns the mode of the cassette.
+func (c *Cassette) Mode() CassetteMode {
+	return c.mode
+}
+
+// ID registers an ID generated by the test to be scrubbed from recorded interactions, and returns it unchanged.
+func (c *Cassette) ID(id string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ids = append(c.ids, id)
+	return id
+}
+
+// UuidProvider returns a function generating random UUIDs registered with ID, to be used with form3.Client.SetUuidProvider.
+func (c *Cassette) UuidProvider() func() string {
+	return func() string {
+		return c.ID(uuid.NewString())
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The request must not be modified, so the body is consumed and a clone with a fresh body is sent instead.
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		out := req.Clone(req.Context())
+		out.Body = io.NopCloser(bytes.NewReader(body))
+		out.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req = out
+	}
+
+	c.mu.Lock()
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Path:   c.scrub(req.URL.Path),
+		Query:  c.scrub(req.URL.Query().Encode()),
+		Body:   c.scrub(normaliseBody(body)),
+	}
+	c.mu.Unlock()
+
+	if c.mode == ModeRecord {
+		return c.record(req, recorded)
+	}
+	return c.replay(req, recorded)
+}
+
+func (c *Cassette) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       c.scrub(string(body)),
+		},
+	})
+	c.used = append(c.used, true)
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, in := range c.interactions {
+		if c.used[i] || in.Request != recorded {
+			continue
+		}
+		c.used[i] = true
+		body := c.unscrub(in.Response.Body)
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("cassette %s has no recorded interaction for %s %s?%s", c.path, recorded.Method, recorded.Path, recorded.Query)
+}
+
+// Save writes the recorded interactions to the cassette file. Does nothing in the replay mode.
+func (c *Cassette) Save() error {
+	if c.mode != ModeRecord {
+		return nil
+	}
+
+	c.mu.Lock()
+	data, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0o644)
+}
+
+// Unused returns the number of recorded interactions that have not been replayed.
+func (c *Cassette) Unused() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := 0
+	for _, used := range c.used {
+		if !used {
+			n++
+		}
+	}
+	return n
+}
+
+func placeholder(i int) string {
+	return fmt.Sprintf("{{id-%d}}", i+1)
+}
+
+// scrub replaces registered IDs with placeholders.
+func (c *Cassette) scrub(s string) string {
+	for i, id := range c.ids {
+		if id != "" {
+			s = strings.ReplaceAll(s, id, placeholder(i))
+		}
+	}
+	return s
+}
+
+// unscrub replaces placeholders with the registered IDs.
+func (c *Cassette) unscrub(s string) string {
+	for i, id := range c.ids {
+		s = strings.ReplaceAll(s, placeholder(i), id)
+	}
+	return s
+}
+
+// normaliseBody re-encodes JSON bodies with sorted keys and no insignificant whitespace. Other bodies are kept as is.
+func normaliseBody(body []byte) string {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return string(body)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,96 @@
+package form3test_test
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3"
+	"mkuznets.com/go/form3/form3test"
+	"mkuznets.com/go/form3/internal/testutils"
+)
+
+func TestCassette(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	run := func(t *testing.T, baseUrl string, cassette *form3test.Cassette) {
+		client := form3.New().
+			SetBaseUrl(baseUrl).
+			SetOrganisationId(organisationId).
+			SetUuidProvider(cassette.UuidProvider()).
+			SetHttpClient(&http.Client{Transport: cassette})
+
+		created, err := client.Accounts().Create(ctx, accountAttributes("41426819"))
+		require.NoError(t, err)
+
+		fetched, err := client.Accounts().Fetch(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, fetched.ID)
+
+		require.NoError(t, client.Accounts().Delete(ctx, created.ID, 0))
+		_, err = client.Accounts().Fetch(ctx, created.ID)
+		assert.ErrorIs(t, err, form3.ErrNotFound)
+
+		_, err = client.Accounts().Fetch(ctx, cassette.ID(uuid.NewString()))
+		assert.ErrorIs(t, err, form3.ErrNotFound)
+	}
+
+	t.Run("record", func(t *testing.T) {
+		srv := form3test.NewServer()
+		defer srv.Close()
+
+		cassette, err := form3test.NewCassette(path, form3test.ModeRecord)
+		require.NoError(t, err)
+		run(t, srv.URL, cassette)
+		require.NoError(t, cassette.Save())
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		cassette, err := form3test.NewCassette(path, form3test.ModeReplay)
+		require.NoError(t, err)
+		run(t, "http://form3.invalid", cassette)
+		assert.Zero(t, cassette.Unused())
+	})
+
+	t.Run("unmatched request", func(t *testing.T) {
+		cassette, err := form3test.NewCassette(path, form3test.ModeReplay)
+		require.NoError(t, err)
+
+		client := form3.New().
+			SetBaseUrl("http://form3.invalid").
+			SetHttpClient(&http.Client{Transport: cassette}).
+			SetBackOffProvider(func() form3.BackOff { return testutils.NewTestBackOff(0) })
+		_, err = client.Accounts().Fetch(ctx, "unknown")
+		assert.ErrorContains(t, err, "no recorded interaction for GET /v1/organisation/accounts/unknown")
+	})
+
+	t.Run("request is not modified", func(t *testing.T) {
+		srv := form3test.NewServer()
+		defer srv.Close()
+
+		cassette, err := form3test.NewCassette(filepath.Join(t.TempDir(), "cassette.json"), form3test.ModeRecord)
+		require.NoError(t, err)
+
+		body := io.NopCloser(strings.NewReader(`{"data": {}}`))
+		req, err := http.NewRequest(http.MethodPost, srv.URL+"/v1/organisation/accounts", body)
+		require.NoError(t, err)
+
+		resp, err := cassette.RoundTrip(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.True(t, req.Body == body, "request body must not be replaced")
+	})
+
+	t.Run("missing cassette", func(t *testing.T) {
+		_, err := form3test.NewCassette(filepath.Join(t.TempDir(), "missing.json"), form3test.ModeReplay)
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+}
@@ -0,0 +1,105 @@
+package form3test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"mkuznets.com/go/form3/models"
+)
+
+const confirmationOfPayeePath = "/v1/services/confirmation-of-payee"
+
+type payeeKey struct {
+	bankID        string
+	accountNumber string
+}
+
+type payeeStore struct {
+	mu      sync.Mutex
+	results map[payeeKey]models.ConfirmationOfPayeeResult
+}
+
+func newPayeeStore() *payeeStore {
+	return &payeeStore{results: make(map[payeeKey]models.ConfirmationOfPayeeResult)}
+}
+
+// SetPayeeResult configures the canned result of Confirmation of Payee checks of the account with the given sort code and account number.
+//
+// Checks of other accounts are answered using the accounts created in the server: a full match if the name is the same
+// as one of the account names ignoring case, models.CopReasonCodeNoMatch if it is not, and models.CopReasonCodeAccountNotFound for unknown accounts.
+func (s *Server) SetPayeeResult(bankID, accountNumber string, result models.ConfirmationOfPayeeResult) {
+	s.payees.mu.Lock()
+	defer s.payees.mu.Unlock()
+	s.payees.results[payeeKey{bankID: bankID, accountNumber: accountNumber}] = result
+}
+
+func (s *payeeStore) check(w http.ResponseWriter, r *http.Request, accounts *accountStore) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, http.StatusText(http.StatusMethodNotAllowed))
+		return
+	}
+
+	request := &models.ConfirmationOfPayeeResource{}
+	if err := json.NewDecoder(r.Body).Decode(&models.Body{Data: request}); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	var missing []string
+	if request.ID == "" {
+		missing = append(missing, "id")
+	}
+	if request.Attributes == nil || request.Attributes.Party == nil {
+		missing = append(missing, "party")
+	} else {
+		party := request.Attributes.Party
+		if party.AccountNumber == "" {
+			missing = append(missing, "account_number")
+		}
+		if party.BankID == "" {
+			missing = append(missing, "bank_id")
+		}
+		if party.Name == "" {
+			missing = append(missing, "name")
+		}
+	}
+	if len(missing) > 0 {
+		writeError(w, http.StatusBadRequest, validationError(missing...))
+		return
+	}
+
+	party := request.Attributes.Party
+	s.mu.Lock()
+	result, ok := s.results[payeeKey{bankID: party.BankID, accountNumber: party.AccountNumber}]
+	s.mu.Unlock()
+	if !ok {
+		result = accounts.checkPayee(party)
+	}
+
+	request.Type = "confirmation_of_payee"
+	request.Attributes.Result = &result
+	writeData(w, http.StatusOK, request)
+}
+
+// checkPayee matches the party against the accounts of the store.
+func (s *accountStore) checkPayee(party *models.ConfirmationOfPayeeParty) models.ConfirmationOfPayeeResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range s.ids {
+		a := s.accounts[id].Attributes
+		if a == nil || a.BankID != party.BankID || a.AccountNumber != party.AccountNumber {
+			continue
+		}
+		for _, name := range a.Name {
+			if strings.EqualFold(name, party.Name) {
+				return models.ConfirmationOfPayeeResult{Matched: true}
+			}
+		}
+		return models.ConfirmationOfPayeeResult{ReasonCode: models.CopReasonCodeNoMatch}
+	}
+	return models.ConfirmationOfPayeeResult{ReasonCode: models.CopReasonCodeAccountNotFound}
+}
@@ -0,0 +1,65 @@
+package form3test
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// pageParams returns the zero-based page number and the page size requested with page[number] and page[size].
+func pageParams(query url.Values) (number, size int, err error) {
+	size = DefaultPageSize
+	if v := query.Get("page[number]"); v != "" {
+		if number, err = strconv.Atoi(v); err != nil || number < 0 {
+			return 0, 0, fmt.Errorf("invalid page[number]: %q", v)
+		}
+	}
+	if v := query.Get("page[size]"); v != "" {
+		if size, err = strconv.Atoi(v); err != nil || size <= 0 || size > MaxPageSize {
+			return 0, 0, fmt.Errorf("invalid page[size]: %q", v)
+		}
+	}
+	return number, size, nil
+}
+
+// paginate returns the requested page of items and the links to the neighbouring pages. Filters of the query are kept in the links.
+func paginate[T any](items []T, path string, query url.Values, number, size int) ([]T, *models.Links) {
+	last := 0
+	if len(items) > 0 {
+		last = (len(items) - 1) / size
+	}
+
+	link := func(n int) string {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("page[number]", strconv.Itoa(n))
+		q.Set("page[size]", strconv.Itoa(size))
+		return fmt.Sprintf("%s?%s", path, q.Encode())
+	}
+
+	links := &models.Links{
+		First: link(0),
+		Last:  link(last),
+		Self:  link(number),
+	}
+	if number < last {
+		links.Next = link(number + 1)
+	}
+	if number > 0 {
+		links.Prev = link(number - 1)
+	}
+
+	start := number * size
+	if start >= len(items) {
+		return nil, links
+	}
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end], links
+}
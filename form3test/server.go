@@ -0,0 +1,162 @@
+// Package form3test provides an in-memory fake of the Form3 API for tests of code using the form3 client.
+//
+//	srv := form3test.NewServer()
+//	defer srv.Close()
+//
+//	client := form3.New().SetBaseUrl(srv.URL)
+package form3test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// Fault is a failure injected into responses of the Server, e.g. to test retries.
+type Fault struct {
+	// Method restricts the fault to requests with the given HTTP method. Empty matches all methods.
+	Method string
+	// PathPrefix restricts the fault to requests with paths starting with the prefix. Empty matches all paths.
+	PathPrefix string
+	// Latency delays the response.
+	Latency time.Duration
+	// StatusCode replaces the response with an error with the given HTTP status, e.g. 500 or 429. Zero keeps the normal response.
+	StatusCode int
+	// RetryAfter is sent in the Retry-After header of the error response if non-zero, rounded up to whole seconds.
+	RetryAfter time.Duration
+	// Times is the number of matching requests affected by the fault, after which it is removed. Zero affects all matching requests.
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	return (f.Method == "" || f.Method == r.Method) && strings.HasPrefix(r.URL.Path, f.PathPrefix)
+}
+
+// Server is an httptest.Server faking the Form3 API. Its state is kept in memory and is safe for concurrent use.
+//
+// Supported endpoints:
+//   - /v1/organisation/accounts: create, fetch, update, delete and list with filters and pagination.
+//     Filters other than bank_id, bank_id_code, account_number, iban and country, e.g. customer_id, are rejected with 400.
+//   - /v1/services/confirmation-of-payee: checks against created accounts or canned results set with SetPayeeResult.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	faults   []*Fault
+	requests int
+	accounts *accountStore
+	payees   *payeeStore
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{accounts: newAccountStore(), payees: newPayeeStore()}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// InjectFault adds a fault to the responses of the server. Faults are matched in the order they were added.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// RequestCount returns the number of requests received by the server, including the ones failed by faults.
+func (s *Server) RequestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// fault returns the first fault matching the request and consumes one of its Times.
+func (s *Server) fault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if f := s.fault(r); f != nil {
+		if f.Latency > 0 {
+			select {
+			case <-time.After(f.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if f.StatusCode != 0 {
+			if f.RetryAfter > 0 {
+				// Retry-After is in whole seconds, and rounding down would turn short delays into 0, which clients ignore.
+				w.Header().Set("Retry-After", strconv.Itoa(int((f.RetryAfter+time.Second-1)/time.Second)))
+			}
+			writeError(w, f.StatusCode, http.StatusText(f.StatusCode))
+			return
+		}
+	}
+
+	switch {
+	case r.URL.Path == accountsPath:
+		s.accounts.serveCollection(w, r)
+	case strings.HasPrefix(r.URL.Path, accountsPath+"/"):
+		s.accounts.serveResource(w, r, strings.TrimPrefix(r.URL.Path, accountsPath+"/"))
+	case r.URL.Path == confirmationOfPayeePath:
+		s.payees.check(w, r, s.accounts)
+	default:
+		writeError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+type errorBody struct {
+	ErrorMessage string `json:"error_message"`
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(w, statusCode, errorBody{ErrorMessage: message})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeData(w http.ResponseWriter, statusCode int, data any) {
+	writeJSON(w, statusCode, models.Body{Data: data})
+}
+
+// validationError formats failures of required fields the way the API does for HTTP 400.
+func validationError(fields ...string) string {
+	lines := []string{"validation failure list:", "validation failure list:"}
+	for _, f := range fields {
+		lines = append(lines, f+" in body is required")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,268 @@
+package form3test_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3"
+	"mkuznets.com/go/form3/form3test"
+	"mkuznets.com/go/form3/internal/testutils"
+	"mkuznets.com/go/form3/models"
+)
+
+const organisationId = "eb0bd6f5-c3f5-44b2-b677-acd23cdde73c"
+
+func newClient(srv *form3test.Server) *form3.Client {
+	return form3.New().
+		SetBaseUrl(srv.URL).
+		SetOrganisationId(organisationId).
+		SetBackOffProvider(func() form3.BackOff { return testutils.NewTestBackOff(3) })
+}
+
+func accountAttributes(accountNumber string) *models.AccountAttributes {
+	return &models.AccountAttributes{
+		AccountNumber: accountNumber,
+		BankID:        "400300",
+		BankIDCode:    models.BankIDCodeGB,
+		Country:       form3.String(models.CountryGB),
+		Name:          []string{"Jane Doe"},
+	}
+}
+
+func TestServer_Accounts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create, fetch, update, delete", func(t *testing.T) {
+		srv := form3test.NewServer()
+		defer srv.Close()
+		client := newClient(srv)
+
+		created, err := client.Accounts().Create(ctx, accountAttributes("41426819"))
+		require.NoError(t, err)
+		assert.Equal(t, 0, *created.Version)
+
+		fetched, err := client.Accounts().Fetch(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "41426819", fetched.Attributes.AccountNumber)
+
+		updated, err := client.Accounts().Update(ctx, created.ID, 0, &models.AccountAttributes{Name: []string{"John Doe"}})
+		require.NoError(t, err)
+		assert.Equal(t, 1, *updated.Version)
+		assert.Equal(t, []string{"John Doe"}, updated.Attributes.Name)
+		assert.Equal(t, "41426819", updated.Attributes.AccountNumber)
+
+		_, err = client.Accounts().Update(ctx, created.ID, 0, &models.AccountAttributes{})
+		assert.ErrorAs(t, err, &form3.VersionConflictError{})
+		assert.ErrorAs(t, err, &form3.CallError{})
+
+		assert.ErrorIs(t, client.Accounts().Delete(ctx, created.ID, 0), form3.ErrConflict)
+		require.NoError(t, client.Accounts().Delete(ctx, created.ID, 1))
+
+		_, err = client.Accounts().Fetch(ctx, created.ID)
+		assert.ErrorIs(t, err, form3.ErrNotFound)
+		assert.ErrorIs(t, client.Accounts().Delete(ctx, created.ID, 1), form3.ErrNotFound)
+	})
+
+	t.Run("duplicate ID", func(t *testing.T) {
+		srv := form3test.NewServer()
+		defer srv.Close()
+
+		id := uuid.NewString()
+		client := newClient(srv).SetUuidProvider(func() string { return id })
+
+		_, err := client.Accounts().Create(ctx, accountAttributes("41426819"))
+		require.NoError(t, err)
+
+		err = client.Api().Do(ctx, &form3.Call{
+			Method:  "POST",
+			Path:    "/v1/organisation/accounts",
+			Request: &models.AccountResource{Resource: models.Resource{ID: id, OrganisationId: organisationId}, Attributes: accountAttributes("1")},
+		})
+		assert.ErrorIs(t, err, form3.ErrConflict)
+
+		// The client resolves the conflict by fetching the existing account.
+		account, err := client.Accounts().Create(ctx, accountAttributes("1"))
+		require.NoError(t, err)
+		assert.Equal(t, "41426819", account.Attributes.AccountNumber)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		srv := form3test.NewServer()
+		defer srv.Close()
+
+		_, err := newClient(srv).Accounts().Create(ctx, &models.AccountAttributes{})
+		var apiErr form3.Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.ErrorIs(t, err, form3.ErrValidation)
+		assert.Equal(t, []form3.FieldError{
+			{Field: "country", Location: "body", Message: "is required"},
+			{Field: "name", Location: "body", Message: "is required"},
+		}, apiErr.FieldErrors())
+
+		_, err = newClient(srv).Accounts().Fetch(ctx, "123")
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		assert.ErrorContains(t, err, "id is not a valid uuid")
+	})
+
+	t.Run("list and pagination", func(t *testing.T) {
+		srv := form3test.NewServer()
+		defer srv.Close()
+		client := newClient(srv)
+
+		for i := 0; i < 5; i++ {
+			_, err := client.Accounts().Create(ctx, accountAttributes(fmt.Sprintf("1000000%d", i)))
+			require.NoError(t, err)
+		}
+
+		page, err := client.Accounts().List(ctx, nil, &form3.ListOptions{PageSize: 2})
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 2)
+		assert.True(t, page.HasNext())
+
+		last, err := client.Accounts().List(ctx, nil, &form3.ListOptions{PageNumber: 2, PageSize: 2})
+		require.NoError(t, err)
+		assert.Len(t, last.Items, 1)
+		assert.False(t, last.HasNext())
+
+		var numbers []string
+		it := client.Accounts().Iter(ctx, nil, &form3.ListOptions{PageSize: 2})
+		for it.Next() {
+			numbers = append(numbers, it.Value().Attributes.AccountNumber)
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, []string{"10000000", "10000001", "10000002", "10000003", "10000004"}, numbers)
+
+		filtered, err := client.Accounts().List(ctx, &form3.AccountFilter{
+			BankID:        "400300",
+			BankIDCode:    models.BankIDCodeGB,
+			AccountNumber: "10000003",
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, filtered.Items, 1)
+		assert.Equal(t, "10000003", filtered.Items[0].Attributes.AccountNumber)
+
+		_, err = client.Accounts().List(ctx, &form3.AccountFilter{CustomerID: "customer-1"}, nil)
+		var e form3.Error
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, http.StatusBadRequest, e.StatusCode)
+		assert.Contains(t, e.Error(), "filter[customer_id]")
+	})
+}
+
+func TestServer_Faults(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("server errors are retried", func(t *testing.T) {
+		srv := form3test.NewServer()
+		defer srv.Close()
+		srv.InjectFault(form3test.Fault{Method: http.MethodPost, StatusCode: http.StatusServiceUnavailable, Times: 2})
+
+		_, err := newClient(srv).Accounts().Create(ctx, accountAttributes("41426819"))
+		require.NoError(t, err)
+		assert.Equal(t, 3, srv.RequestCount())
+	})
+
+	t.Run("rate limiting", func(t *testing.T) {
+		srv := form3test.NewServer()
+		defer srv.Close()
+		srv.InjectFault(form3test.Fault{PathPrefix: "/v1/organisation", StatusCode: http.StatusTooManyRequests, RetryAfter: time.Second})
+
+		client := newClient(srv).SetBackOffProvider(func() form3.BackOff { return testutils.NewTestBackOff(0) })
+		_, err := client.Accounts().Fetch(ctx, uuid.NewString())
+		var apiErr form3.Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.ErrorIs(t, err, form3.ErrRateLimited)
+		assert.Equal(t, time.Second, apiErr.RetryAfter)
+
+		srv.ClearFaults()
+		srv.InjectFault(form3test.Fault{StatusCode: http.StatusTooManyRequests, RetryAfter: 100 * time.Millisecond, Times: 1})
+		_, err = client.Accounts().Fetch(ctx, uuid.NewString())
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, time.Second, apiErr.RetryAfter, "sub-second delays are rounded up")
+
+		_, err = client.Accounts().Fetch(ctx, uuid.NewString())
+		assert.ErrorIs(t, err, form3.ErrNotFound)
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		srv := form3test.NewServer()
+		defer srv.Close()
+		srv.InjectFault(form3test.Fault{Latency: 200 * time.Millisecond, Times: 1})
+
+		client := newClient(srv).SetAttemptTimeout(50 * time.Millisecond)
+		_, err := client.Accounts().Create(ctx, accountAttributes("41426819"))
+		require.NoError(t, err)
+		assert.Equal(t, 2, srv.RequestCount())
+	})
+}
+
+func TestServer_ConfirmationOfPayee(t *testing.T) {
+	ctx := context.Background()
+	srv := form3test.NewServer()
+	defer srv.Close()
+	client := newClient(srv)
+
+	_, err := client.Accounts().Create(ctx, accountAttributes("41426819"))
+	require.NoError(t, err)
+	joint := accountAttributes("55779911")
+	joint.Name = []string{"Jane Doe", "John Doe"}
+	_, err = client.Accounts().Create(ctx, joint)
+	require.NoError(t, err)
+	srv.SetPayeeResult("400300", "70872490", models.ConfirmationOfPayeeResult{
+		ReasonCode: models.CopReasonCodeCloseMatch,
+		ActualName: "Jane Doe",
+	})
+
+	tests := []struct {
+		name    string
+		account *models.AccountAttributes
+		want    form3.PayeeMatch
+	}{
+		{
+			name:    "created account",
+			account: &models.AccountAttributes{AccountNumber: "41426819", BankID: "400300", Name: []string{"JANE DOE"}},
+			want:    form3.PayeeMatch{Result: form3.MatchFull},
+		},
+		{
+			name:    "joint account",
+			account: joint,
+			want:    form3.PayeeMatch{Result: form3.MatchFull},
+		},
+		{
+			name:    "created account with another name",
+			account: &models.AccountAttributes{AccountNumber: "41426819", BankID: "400300", Name: []string{"John Doe"}},
+			want:    form3.PayeeMatch{Result: form3.MatchNone, ReasonCode: models.CopReasonCodeNoMatch},
+		},
+		{
+			name:    "canned result",
+			account: &models.AccountAttributes{AccountNumber: "70872490", BankID: "400300", Name: []string{"Jane Do"}},
+			want:    form3.PayeeMatch{Result: form3.MatchClose, ReasonCode: models.CopReasonCodeCloseMatch, SuggestedName: "Jane Doe"},
+		},
+		{
+			name:    "unknown account",
+			account: &models.AccountAttributes{AccountNumber: "00000000", BankID: "400300", Name: []string{"Jane Doe"}},
+			want:    form3.PayeeMatch{Result: form3.MatchAccountNotFound, ReasonCode: models.CopReasonCodeAccountNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := client.ConfirmationOfPayee().Check(ctx, tt.account)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *match)
+		})
+	}
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := client.ConfirmationOfPayee().Check(ctx, &models.AccountAttributes{AccountNumber: "41426819"})
+		assert.ErrorIs(t, err, form3.ErrValidation)
+		_, err = client.ConfirmationOfPayee().Check(ctx, nil)
+		assert.ErrorIs(t, err, form3.ErrValidation)
+	})
+}
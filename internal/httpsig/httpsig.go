@@ -0,0 +1,200 @@
+// Package httpsig implements signing and verification of HTTP messages according to the
+// HTTP Signatures draft (draft-cavage-http-signatures) as used by the Form3 API.
+package httpsig
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Algorithms of the signature.
+const (
+	AlgorithmRsaSha256   = "rsa-sha256"
+	AlgorithmEcdsaSha256 = "ecdsa-sha256"
+)
+
+// RequestTarget is the pseudo-header covering the method and the path of the request.
+const RequestTarget = "(request-target)"
+
+// DefaultHeaders are the headers covered by the signature.
+var DefaultHeaders = []string{RequestTarget, "host", "date", "content-length", "digest"}
+
+// KeyFunc returns the public key for the given key ID.
+type KeyFunc func(keyID string) (crypto.PublicKey, error)
+
+// Digest returns the value of the Digest header for the given body.
+func Digest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// SigningString returns the string to sign for the given request and the list of covered headers.
+func SigningString(req *http.Request, headers []string) (string, error) {
+	lines := make([]string, 0, len(headers))
+	for _, h := range headers {
+		h = strings.ToLower(h)
+		var value string
+		switch h {
+		case RequestTarget:
+			value = fmt.Sprintf("%s %s", strings.ToLower(req.Method), req.URL.RequestURI())
+		case "host":
+			value = req.Host
+			if value == "" {
+				value = req.URL.Host
+			}
+		case "content-length":
+			value = req.Header.Get("Content-Length")
+			if value == "" {
+				value = strconv.FormatInt(max64(req.ContentLength, 0), 10)
+			}
+		default:
+			values, ok := req.Header[http.CanonicalHeaderKey(h)]
+			if !ok {
+				return "", fmt.Errorf("missing header %q", h)
+			}
+			value = strings.Join(values, ", ")
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", h, value))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// Sign signs the request with the given key and sets the Authorization header.
+// Headers covered by the signature (such as Date and Digest) must be set beforehand.
+func Sign(req *http.Request, keyID string, key crypto.Signer, headers []string) error {
+	var algorithm string
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+		algorithm = AlgorithmRsaSha256
+	case *ecdsa.PublicKey:
+		algorithm = AlgorithmEcdsaSha256
+	default:
+		return fmt.Errorf("unsupported key type %T", key.Public())
+	}
+
+	s, err := SigningString(req, headers)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256([]byte(s))
+	signature, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf(`Signature keyId="%s",algorithm="%s",headers="%s",signature="%s"`,
+		keyID, algorithm, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(signature)))
+	return nil
+}
+
+// Verify verifies the signature in the Authorization header of the request using the public key returned by keys.
+// If the Digest header is covered by the signature, it is also checked against the body. Returns the ID of the key.
+func Verify(req *http.Request, body []byte, keys KeyFunc) (string, error) {
+	params, err := parseAuthorization(req.Header.Get("Authorization"))
+	if err != nil {
+		return "", err
+	}
+	keyID := params["keyId"]
+
+	headers := signedHeaders(params)
+
+	signature, err := base64.StdEncoding.DecodeString(params["signature"])
+	if err != nil {
+		return keyID, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	key, err := keys(keyID)
+	if err != nil {
+		return keyID, err
+	}
+
+	s, err := SigningString(req, headers)
+	if err != nil {
+		return keyID, err
+	}
+	digest := sha256.Sum256([]byte(s))
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature); err != nil {
+			return keyID, errors.New("invalid signature")
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest[:], signature) {
+			return keyID, errors.New("invalid signature")
+		}
+	default:
+		return keyID, fmt.Errorf("unsupported key type %T", key)
+	}
+
+	for _, h := range headers {
+		if h == "digest" {
+			if subtle.ConstantTimeCompare([]byte(req.Header.Get("Digest")), []byte(Digest(body))) != 1 {
+				return keyID, errors.New("digest mismatch")
+			}
+		}
+	}
+
+	return keyID, nil
+}
+
+// SignedHeaders returns the lowercase names of the headers covered by the signature in the Authorization header of the request.
+func SignedHeaders(req *http.Request) ([]string, error) {
+	params, err := parseAuthorization(req.Header.Get("Authorization"))
+	if err != nil {
+		return nil, err
+	}
+	return signedHeaders(params), nil
+}
+
+func signedHeaders(params map[string]string) []string {
+	v, ok := params["headers"]
+	if !ok {
+		return []string{"date"}
+	}
+	headers := strings.Fields(v)
+	for i, h := range headers {
+		headers[i] = strings.ToLower(h)
+	}
+	return headers
+}
+
+func parseAuthorization(v string) (map[string]string, error) {
+	const prefix = "Signature "
+	if !strings.HasPrefix(v, prefix) {
+		return nil, errors.New("missing signature")
+	}
+
+	params := make(map[string]string)
+	for _, part := range strings.Split(strings.TrimPrefix(v, prefix), ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid signature parameter %q", part)
+		}
+		params[key] = strings.Trim(value, `"`)
+	}
+
+	for _, key := range []string{"keyId", "signature"} {
+		if params[key] == "" {
+			return nil, fmt.Errorf("missing signature parameter %q", key)
+		}
+	}
+	return params, nil
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
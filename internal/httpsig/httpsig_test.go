@@ -0,0 +1,93 @@
+package httpsig_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3/internal/httpsig"
+)
+
+func signedRequest(t *testing.T, key crypto.Signer, body []byte) *http.Request {
+	req, err := http.NewRequest("POST", "https://api.form3.tech/v1/resource?a=1", bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Date", "Tue, 07 Jun 2022 20:51:35 GMT")
+	req.Header.Set("Digest", httpsig.Digest(body))
+	require.NoError(t, httpsig.Sign(req, "key-1", key, httpsig.DefaultHeaders))
+	return req
+}
+
+func TestSigningString(t *testing.T) {
+	req, err := http.NewRequest("GET", "https://api.form3.tech/v1/resource?a=1", nil)
+	require.NoError(t, err)
+	req.Header.Set("Date", "Tue, 07 Jun 2022 20:51:35 GMT")
+	req.Header.Set("Digest", httpsig.Digest(nil))
+
+	s, err := httpsig.SigningString(req, httpsig.DefaultHeaders)
+	require.NoError(t, err)
+	assert.Equal(t, "(request-target): get /v1/resource?a=1\n"+
+		"host: api.form3.tech\n"+
+		"date: Tue, 07 Jun 2022 20:51:35 GMT\n"+
+		"content-length: 0\n"+
+		"digest: SHA-256=47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=", s)
+
+	_, err = httpsig.SigningString(req, []string{"x-missing"})
+	assert.ErrorContains(t, err, `missing header "x-missing"`)
+}
+
+func TestVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keys := func(keyID string) (crypto.PublicKey, error) {
+		return key.Public(), nil
+	}
+	body := []byte(`{"data":{}}`)
+
+	t.Run("valid", func(t *testing.T) {
+		req := signedRequest(t, key, body)
+		keyID, err := httpsig.Verify(req, body, keys)
+		require.NoError(t, err)
+		assert.Equal(t, "key-1", keyID)
+	})
+
+	t.Run("tampered header", func(t *testing.T) {
+		req := signedRequest(t, key, body)
+		req.Header.Set("Date", "Wed, 08 Jun 2022 20:51:35 GMT")
+		_, err := httpsig.Verify(req, body, keys)
+		assert.ErrorContains(t, err, "invalid signature")
+	})
+
+	t.Run("tampered body", func(t *testing.T) {
+		req := signedRequest(t, key, body)
+		_, err := httpsig.Verify(req, []byte(`{"data":{"x":1}}`), keys)
+		assert.ErrorContains(t, err, "digest mismatch")
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		req := signedRequest(t, key, body)
+		req.Header.Del("Authorization")
+		_, err := httpsig.Verify(req, body, keys)
+		assert.ErrorContains(t, err, "missing signature")
+	})
+}
+
+func TestSignedHeaders(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	headers, err := httpsig.SignedHeaders(signedRequest(t, key, nil))
+	require.NoError(t, err)
+	assert.Equal(t, httpsig.DefaultHeaders, headers)
+
+	req := signedRequest(t, key, nil)
+	req.Header.Set("Authorization", `Signature keyId="key-1",signature="c2ln"`)
+	headers, err = httpsig.SignedHeaders(req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"date"}, headers)
+}
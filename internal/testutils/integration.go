@@ -1,22 +1,72 @@
 package testutils
 
 import (
+	"errors"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"mkuznets.com/go/form3/form3test"
 )
 
-const BaseUrlEnvName = "FORM3_API_BASE_URL"
+const (
+	BaseUrlEnvName = "FORM3_API_BASE_URL"
+	// RecordEnvName enables recording of cassettes against the API at FORM3_API_BASE_URL.
+	RecordEnvName = "FORM3_RECORD_CASSETTES"
+	// CassetteDir is the directory of cassettes relative to the package of the test.
+	CassetteDir = "testdata/cassettes"
+	// DefaultBaseUrl is the base URL used when replaying cassettes.
+	DefaultBaseUrl = "http://localhost:8080"
+)
 
-func EnsureIntegration(t *testing.T) {
+// EnsureIntegration skips the test unless it can run against the API, and returns the Cassette to use as the client transport.
+//   - With FORM3_API_BASE_URL and FORM3_RECORD_CASSETTES set, the test calls the API and records its cassette.
+//   - With FORM3_API_BASE_URL set, the test calls the API without recording.
+//   - Otherwise, the test replays its recorded cassette, and is skipped if there is none.
+func EnsureIntegration(t *testing.T) *form3test.Cassette {
 	if testing.Short() {
 		t.Skip("-short is enabled, skipping integration test")
 	}
 
-	if _, ok := os.LookupEnv(BaseUrlEnvName); !ok {
-		t.Skipf("%s environment variable is required", BaseUrlEnvName)
+	path := filepath.Join(CassetteDir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	_, live := os.LookupEnv(BaseUrlEnvName)
+	_, record := os.LookupEnv(RecordEnvName)
+
+	if live {
+		cassette, err := form3test.NewCassette(path, form3test.ModeRecord)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if record {
+			t.Cleanup(func() {
+				if err := cassette.Save(); err != nil {
+					t.Errorf("saving cassette: %v", err)
+				}
+			})
+		}
+		return cassette
 	}
+
+	cassette, err := form3test.NewCassette(path, form3test.ModeReplay)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("%s environment variable is required to run against the API, or a cassette recorded at %s with %s", BaseUrlEnvName, path, RecordEnvName)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if n := cassette.Unused(); n > 0 {
+			t.Errorf("%d interaction(s) of cassette %s were not replayed", n, path)
+		}
+	})
+	return cassette
 }
 
 func BaseUrl() string {
-	return os.Getenv(BaseUrlEnvName)
+	if v, ok := os.LookupEnv(BaseUrlEnvName); ok {
+		return v
+	}
+	return DefaultBaseUrl
 }
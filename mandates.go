@@ -0,0 +1,133 @@
+package form3
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// MandatesClient is the Form3 API client for /v1/transaction/mandates endpoints.
+// Mandates authorise originators to collect direct debits from the accounts of payers.
+type MandatesClient interface {
+	// Create a new mandate. The mandate is not sent to the scheme until a submission is created.
+	Create(ctx context.Context, attributes *models.MandateAttributes) (*models.MandateResource, error)
+	// Fetch a single Mandate resource using the resource ID.
+	Fetch(ctx context.Context, id string) (*models.MandateResource, error)
+	// List a single page of Mandate resources matching an optional filter.
+	List(ctx context.Context, filter *MandateFilter, opts *ListOptions) (*Page[*models.MandateResource], error)
+	// Iter returns an Iterator over all Mandate resources matching an optional filter, starting from the page given in opts. Pages are fetched lazily.
+	Iter(ctx context.Context, filter *MandateFilter, opts *ListOptions) *Iterator[*models.MandateResource]
+	// CreateSubmission submits the mandate to the payment scheme.
+	CreateSubmission(ctx context.Context, mandateID string) (*models.MandateSubmissionResource, error)
+	// FetchSubmission fetches a single Mandate Submission resource using the mandate ID and the submission ID.
+	FetchSubmission(ctx context.Context, mandateID, submissionID string) (*models.MandateSubmissionResource, error)
+	// CreateCancellation cancels the mandate, so that no further direct debits are collected under it.
+	CreateCancellation(ctx context.Context, mandateID string, attributes *models.MandateCancellationAttributes) (*models.MandateCancellationResource, error)
+	// FetchCancellation fetches a single Mandate Cancellation resource using the mandate ID and the cancellation ID.
+	FetchCancellation(ctx context.Context, mandateID, cancellationID string) (*models.MandateCancellationResource, error)
+	// Returns returns ReturnsClient to access returns of mandates.
+	Returns() ReturnsClient
+}
+
+// MandateFilter restricts the Mandate resources returned by list endpoints. Empty fields are ignored.
+type MandateFilter struct {
+	PaymentScheme string
+	// ProcessingDateFrom is the earliest processing date in the YYYY-MM-DD format.
+	ProcessingDateFrom string
+	// ProcessingDateTo is the latest processing date in the YYYY-MM-DD format.
+	ProcessingDateTo string
+}
+
+func (f *MandateFilter) queryParams() (url.Values, error) {
+	if f == nil {
+		return url.Values{}, nil
+	}
+	if err := validateProcessingDates("mandate", f.ProcessingDateFrom, f.ProcessingDateTo); err != nil {
+		return nil, err
+	}
+
+	return filterParams(map[string]string{
+		"payment_scheme":       f.PaymentScheme,
+		"processing_date_from": f.ProcessingDateFrom,
+		"processing_date_to":   f.ProcessingDateTo,
+	}), nil
+}
+
+const mandatesPath = "/v1/transaction/mandates"
+
+type mandatesClient struct {
+	c       *Client
+	returns ReturnsClient
+}
+
+func newMandatesClient(c *Client) *mandatesClient {
+	return &mandatesClient{
+		c:       c,
+		returns: &returnsClient{c: c, base: mandatesPath},
+	}
+}
+
+func (s *mandatesClient) Create(ctx context.Context, attributes *models.MandateAttributes) (*models.MandateResource, error) {
+	request := &models.MandateResource{
+		Resource:   s.c.newResource("mandates"),
+		Attributes: attributes,
+	}
+	return createResource[models.MandateResource](ctx, s.c, mandatesPath, request.ID, request)
+}
+
+func (s *mandatesClient) Fetch(ctx context.Context, id string) (*models.MandateResource, error) {
+	return fetchResource[models.MandateResource](ctx, s.c, fmt.Sprintf("%s/%s", mandatesPath, id))
+}
+
+func (s *mandatesClient) List(ctx context.Context, filter *MandateFilter, opts *ListOptions) (*Page[*models.MandateResource], error) {
+	params, err := listParams(filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.list(ctx, params)
+}
+
+func (s *mandatesClient) Iter(ctx context.Context, filter *MandateFilter, opts *ListOptions) *Iterator[*models.MandateResource] {
+	params, err := listParams(filter, opts)
+	if err != nil {
+		return newFailedIterator[*models.MandateResource](err)
+	}
+	return newIterator(ctx, params, s.list)
+}
+
+func (s *mandatesClient) list(ctx context.Context, params url.Values) (*Page[*models.MandateResource], error) {
+	return listPage[*models.MandateResource](ctx, s.c, mandatesPath, params)
+}
+
+func (s *mandatesClient) CreateSubmission(ctx context.Context, mandateID string) (*models.MandateSubmissionResource, error) {
+	request := &models.MandateSubmissionResource{
+		Resource: s.c.newResource("mandate_submissions"),
+	}
+	p := subPath(mandatesPath, mandateID, "submissions")
+	return createResource[models.MandateSubmissionResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *mandatesClient) FetchSubmission(ctx context.Context, mandateID, submissionID string) (*models.MandateSubmissionResource, error) {
+	p := subPath(mandatesPath, mandateID, "submissions", submissionID)
+	return fetchResource[models.MandateSubmissionResource](ctx, s.c, p)
+}
+
+func (s *mandatesClient) CreateCancellation(ctx context.Context, mandateID string, attributes *models.MandateCancellationAttributes) (*models.MandateCancellationResource, error) {
+	request := &models.MandateCancellationResource{
+		Resource:   s.c.newResource("mandate_cancellations"),
+		Attributes: attributes,
+	}
+	p := subPath(mandatesPath, mandateID, "cancellations")
+	return createResource[models.MandateCancellationResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *mandatesClient) FetchCancellation(ctx context.Context, mandateID, cancellationID string) (*models.MandateCancellationResource, error) {
+	p := subPath(mandatesPath, mandateID, "cancellations", cancellationID)
+	return fetchResource[models.MandateCancellationResource](ctx, s.c, p)
+}
+
+func (s *mandatesClient) Returns() ReturnsClient {
+	return s.returns
+}
@@ -0,0 +1,45 @@
+package form3
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// ApiFunc is an adapter to allow the use of ordinary functions as Api.
+type ApiFunc func(ctx context.Context, call *Call) error
+
+// Do calls f(ctx, call).
+func (f ApiFunc) Do(ctx context.Context, call *Call) error {
+	return f(ctx, call)
+}
+
+// Middleware wraps Api to add behaviour around every API call, such as logging, metrics, tracing or custom headers.
+type Middleware func(next Api) Api
+
+// apiLink forwards calls to next, so that middlewares registered later can be inserted inside the ones already built.
+type apiLink struct {
+	next Api
+}
+
+func (l *apiLink) Do(ctx context.Context, call *Call) error {
+	return l.next.Do(ctx, call)
+}
+
+// Attempt describes a single HTTP request made while performing a Call.
+type Attempt struct {
+	// Call is the API call being performed.
+	Call *Call
+	// Index is the zero-based number of the attempt.
+	Index int
+	// Response is the HTTP response of the attempt. It is nil if no response was received.
+	// The response body must not be read.
+	Response *http.Response
+	// Err is the error of the attempt, such as a network error or an Error decoded from the response.
+	Err error
+	// Delay is the back-off delay before the next attempt. It is negative if no more attempts will be made.
+	Delay time.Duration
+}
+
+// AttemptHook is invoked after every attempt to perform a Call, including retries.
+type AttemptHook func(ctx context.Context, attempt *Attempt)
@@ -0,0 +1,118 @@
+package form3_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3"
+)
+
+func TestClient_AddMiddleware(t *testing.T) {
+	var headers []http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		_, _ = w.Write([]byte(`{"data":{"id":"123"}}`))
+	}))
+	defer ts.Close()
+
+	var order []string
+	tracing := func(name string) form3.Middleware {
+		return func(next form3.Api) form3.Api {
+			return form3.ApiFunc(func(ctx context.Context, call *form3.Call) error {
+				order = append(order, name+" before "+call.Method+" "+call.Path)
+				err := next.Do(ctx, call)
+				order = append(order, name+" after")
+				return err
+			})
+		}
+	}
+	customHeader := func(next form3.Api) form3.Api {
+		return form3.ApiFunc(func(ctx context.Context, call *form3.Call) error {
+			if call.Header == nil {
+				call.Header = http.Header{}
+			}
+			call.Header.Set("X-Custom", "value")
+			return next.Do(ctx, call)
+		})
+	}
+
+	client := form3.New().SetBaseUrl(ts.URL).AddMiddleware(tracing("outer"), tracing("inner"), customHeader)
+
+	_, err := client.Accounts().Fetch(context.Background(), "123")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"outer before GET /v1/organisation/accounts/123",
+		"inner before GET /v1/organisation/accounts/123",
+		"inner after",
+		"outer after",
+	}, order)
+	require.Len(t, headers, 1)
+	assert.Equal(t, "value", headers[0].Get("X-Custom"))
+}
+
+func TestClient_AddMiddleware_CalledOnce(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"id":"123"}}`))
+	}))
+	defer ts.Close()
+
+	var order []string
+	built := map[string]int{}
+	tracing := func(name string) form3.Middleware {
+		return func(next form3.Api) form3.Api {
+			built[name]++
+			return form3.ApiFunc(func(ctx context.Context, call *form3.Call) error {
+				order = append(order, name)
+				return next.Do(ctx, call)
+			})
+		}
+	}
+
+	client := form3.New().SetBaseUrl(ts.URL).AddMiddleware(tracing("first")).AddMiddleware(tracing("second"), tracing("third"))
+	for i := 0; i < 3; i++ {
+		_, err := client.Accounts().Fetch(context.Background(), "123")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, map[string]int{"first": 1, "second": 1, "third": 1}, built)
+	assert.Equal(t, []string{"first", "second", "third"}, order[:3])
+	assert.Len(t, order, 9)
+}
+
+func TestClient_AddAttemptHook(t *testing.T) {
+	handlerMock := failingHandlerMock(2, http.StatusInternalServerError)
+	ts := httptest.NewServer(handlerMock)
+	defer ts.Close()
+
+	var attempts []form3.Attempt
+	client := form3.New().
+		SetBaseUrl(ts.URL).
+		SetBackOffProvider(testBackOff(5)).
+		AddAttemptHook(func(ctx context.Context, attempt *form3.Attempt) {
+			attempts = append(attempts, *attempt)
+		})
+
+	call := &form3.Call{Method: "GET", Path: "/v1/resource"}
+	err := client.Api().Do(context.Background(), call)
+	require.NoError(t, err)
+
+	require.Len(t, attempts, 3)
+	for i, attempt := range attempts {
+		assert.Equal(t, i, attempt.Index)
+		assert.Same(t, call, attempt.Call)
+	}
+
+	assert.ErrorContains(t, attempts[0].Err, "HTTP 500: API error message")
+	assert.Equal(t, http.StatusInternalServerError, attempts[0].Response.StatusCode)
+	assert.Equal(t, time.Duration(0), attempts[0].Delay)
+
+	assert.NoError(t, attempts[2].Err)
+	assert.Equal(t, http.StatusOK, attempts[2].Response.StatusCode)
+	assert.Less(t, attempts[2].Delay, time.Duration(0))
+}
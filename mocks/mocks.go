@@ -0,0 +1,84 @@
+// Package mocks provides mocks of the form3 client interfaces generated with moq, and helpers to stub their responses.
+//
+//	accounts := &mocks.AccountsClientMock{
+//		FetchFunc: func(ctx context.Context, id string) (*models.AccountResource, error) {
+//			return nil, mocks.Fail(http.StatusNotFound, "record does not exist")
+//		},
+//	}
+//
+//	api := &mocks.ApiMock{
+//		DoFunc: mocks.RespondData(&models.AccountResource{Resource: models.Resource{ID: "123"}}),
+//	}
+package mocks
+
+//go:generate moq -pkg mocks -out api_mock.go .. Api
+//go:generate moq -pkg mocks -out accounts_client_mock.go .. AccountsClient
+//go:generate moq -pkg mocks -out payments_client_mock.go .. PaymentsClient
+//go:generate moq -pkg mocks -out direct_debits_client_mock.go .. DirectDebitsClient
+//go:generate moq -pkg mocks -out mandates_client_mock.go .. MandatesClient
+//go:generate moq -pkg mocks -out returns_client_mock.go .. ReturnsClient
+//go:generate moq -pkg mocks -out reversals_client_mock.go .. ReversalsClient
+//go:generate moq -pkg mocks -out recalls_client_mock.go .. RecallsClient
+//go:generate moq -pkg mocks -out subscriptions_client_mock.go .. SubscriptionsClient
+//go:generate moq -pkg mocks -out confirmation_of_payee_client_mock.go .. ConfirmationOfPayeeClient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"mkuznets.com/go/form3"
+	"mkuznets.com/go/form3/models"
+)
+
+// Respond returns a function for ApiMock.DoFunc that fills the call with the response envelope as if it was returned by the API:
+// Data is decoded into Call.Response, Links, Meta and Included into the respective fields, and the JSON is written to Call.Stream if set.
+func Respond(body models.Body) func(ctx context.Context, call *form3.Call) error {
+	return func(ctx context.Context, call *form3.Call) error {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		if call.ResponseInfo != nil {
+			*call.ResponseInfo = form3.ResponseInfo{StatusCode: http.StatusOK, Header: http.Header{}}
+		}
+		if call.Stream != nil {
+			_, err = call.Stream.Write(data)
+			return err
+		}
+		return json.NewDecoder(bytes.NewReader(data)).Decode(&models.Body{
+			Data:     call.Response,
+			Links:    call.Links,
+			Meta:     call.Meta,
+			Included: call.Included,
+		})
+	}
+}
+
+// RespondData is a shortcut for Respond with the given data and an empty envelope otherwise.
+func RespondData(data any) func(ctx context.Context, call *form3.Call) error {
+	return Respond(models.Body{Data: data})
+}
+
+// RespondError returns a function for ApiMock.DoFunc that fails every call with the given error.
+func RespondError(err error) func(ctx context.Context, call *form3.Call) error {
+	return func(ctx context.Context, call *form3.Call) error {
+		return err
+	}
+}
+
+// Fail returns an API error with the given HTTP status code and message, as returned by the API.
+func Fail(statusCode int, message string) form3.Error {
+	return form3.Error{StatusCode: statusCode, ResponseErrorMessage: message}
+}
+
+// Page returns a single page of the given resources without further pages, e.g. to stub List methods.
+func Page[T any](items ...T) *form3.Page[T] {
+	return &form3.Page[T]{Items: items}
+}
+
+// Iterator returns an Iterator over the given resources, e.g. to stub Iter methods. See form3.NewStaticIterator to stub failures.
+func Iterator[T any](items ...T) *form3.Iterator[T] {
+	return form3.NewStaticIterator(items, nil)
+}
@@ -0,0 +1,119 @@
+package mocks_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3"
+	"mkuznets.com/go/form3/mocks"
+	"mkuznets.com/go/form3/models"
+)
+
+func TestRespond(t *testing.T) {
+	count := 2
+	api := &mocks.ApiMock{
+		DoFunc: mocks.Respond(models.Body{
+			Data:  []*models.AccountResource{{Resource: models.Resource{ID: "1"}}, {Resource: models.Resource{ID: "2"}}},
+			Links: &models.Links{Self: "/v1/organisation/accounts"},
+			Meta:  &models.Meta{Count: &count},
+		}),
+	}
+
+	var accounts []*models.AccountResource
+	var links models.Links
+	var meta models.Meta
+	var info form3.ResponseInfo
+	err := api.Do(context.Background(), &form3.Call{
+		Method:       "GET",
+		Path:         "/v1/organisation/accounts",
+		Response:     &accounts,
+		Links:        &links,
+		Meta:         &meta,
+		ResponseInfo: &info,
+	})
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	assert.Equal(t, "2", accounts[1].ID)
+	assert.Equal(t, "/v1/organisation/accounts", links.Self)
+	assert.Equal(t, 2, *meta.Count)
+	assert.Equal(t, http.StatusOK, info.StatusCode)
+
+	require.Len(t, api.DoCalls(), 1)
+	assert.Equal(t, "/v1/organisation/accounts", api.DoCalls()[0].Call.Path)
+
+	var buf bytes.Buffer
+	require.NoError(t, api.Do(context.Background(), &form3.Call{Method: "GET", Stream: &buf}))
+	assert.Contains(t, buf.String(), `"self":"/v1/organisation/accounts"`)
+}
+
+func TestRespondError(t *testing.T) {
+	api := &mocks.ApiMock{DoFunc: mocks.RespondError(mocks.Fail(http.StatusNotFound, "record does not exist"))}
+
+	err := api.Do(context.Background(), &form3.Call{Method: "GET"})
+	assert.ErrorIs(t, err, form3.ErrNotFound)
+	assert.EqualError(t, err, "HTTP 404: record does not exist")
+}
+
+func TestAccountsClientMock(t *testing.T) {
+	account := &models.AccountResource{Resource: models.Resource{ID: "123"}}
+	var client form3.AccountsClient = &mocks.AccountsClientMock{
+		FetchFunc: func(ctx context.Context, id string) (*models.AccountResource, error) {
+			return account, nil
+		},
+		ListFunc: func(ctx context.Context, filter *form3.AccountFilter, opts *form3.ListOptions) (*form3.Page[*models.AccountResource], error) {
+			return mocks.Page(account), nil
+		},
+		IterFunc: func(ctx context.Context, filter *form3.AccountFilter, opts *form3.ListOptions) *form3.Iterator[*models.AccountResource] {
+			return mocks.Iterator(account, account)
+		},
+	}
+
+	got, err := client.Fetch(context.Background(), "123")
+	require.NoError(t, err)
+	assert.Same(t, account, got)
+	assert.Equal(t, "123", client.(*mocks.AccountsClientMock).FetchCalls()[0].Id)
+
+	page, err := client.List(context.Background(), nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.False(t, page.HasNext())
+
+	n := 0
+	it := client.Iter(context.Background(), nil, nil)
+	for it.Next() {
+		assert.Same(t, account, it.Value())
+		n++
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, 2, n)
+}
+
+func TestPaymentsClientMock(t *testing.T) {
+	returns := &mocks.ReturnsClientMock{
+		FetchFunc: func(ctx context.Context, paymentID string, returnID string) (*models.ReturnResource, error) {
+			return &models.ReturnResource{Resource: models.Resource{ID: returnID}}, nil
+		},
+	}
+	failure := errors.New("iteration failed")
+	var client form3.PaymentsClient = &mocks.PaymentsClientMock{
+		ReturnsFunc: func() form3.ReturnsClient { return returns },
+		IterFunc: func(ctx context.Context, filter *form3.PaymentFilter, opts *form3.ListOptions) *form3.Iterator[*models.PaymentResource] {
+			return form3.NewStaticIterator([]*models.PaymentResource{{}}, failure)
+		},
+	}
+
+	ret, err := client.Returns().Fetch(context.Background(), "p1", "r1")
+	require.NoError(t, err)
+	assert.Equal(t, "r1", ret.ID)
+	assert.Equal(t, "p1", returns.FetchCalls()[0].TransactionID)
+
+	it := client.Iter(context.Background(), nil, nil)
+	assert.True(t, it.Next())
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), failure)
+}
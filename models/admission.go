@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+type AdmissionAttributes struct {
+	AdmissionDatetime *time.Time `json:"admission_datetime,omitempty"`
+	SchemeStatusCode  string     `json:"scheme_status_code,omitempty"`
+	SettlementCycle   *int       `json:"settlement_cycle,omitempty"`
+	SettlementDate    string     `json:"settlement_date,omitempty"`
+	Status            string     `json:"status,omitempty"`
+	StatusReason      string     `json:"status_reason,omitempty"`
+}
@@ -1,5 +1,20 @@
 package models
 
 type Body struct {
-	Data any `json:"data"`
+	Data     any    `json:"data"`
+	Links    *Links `json:"links,omitempty"`
+	Meta     *Meta  `json:"meta,omitempty"`
+	Included any    `json:"included,omitempty"`
+}
+
+type Links struct {
+	First string `json:"first,omitempty"`
+	Last  string `json:"last,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Self  string `json:"self,omitempty"`
+}
+
+type Meta struct {
+	Count *int `json:"count,omitempty"`
 }
@@ -0,0 +1,31 @@
+package models
+
+type ConfirmationOfPayeeResource struct {
+	Resource
+	Attributes *ConfirmationOfPayeeAttributes `json:"attributes,omitempty"`
+}
+
+type ConfirmationOfPayeeAttributes struct {
+	// Party is the account to check, sent in the request.
+	Party *ConfirmationOfPayeeParty `json:"party,omitempty"`
+	// Result is the outcome of the check, returned in the response.
+	Result *ConfirmationOfPayeeResult `json:"result,omitempty"`
+}
+
+type ConfirmationOfPayeeParty struct {
+	AccountNumber           string `json:"account_number,omitempty"`
+	AccountNumberCode       string `json:"account_number_code,omitempty"`
+	AccountType             *int   `json:"account_type,omitempty"`
+	BankID                  string `json:"bank_id,omitempty"`
+	BankIDCode              string `json:"bank_id_code,omitempty"`
+	Name                    string `json:"name,omitempty"`
+	SecondaryIdentification string `json:"secondary_identification,omitempty"`
+}
+
+type ConfirmationOfPayeeResult struct {
+	Matched bool `json:"matched"`
+	// ReasonCode explains a failed match, e.g. CopReasonCodeCloseMatch.
+	ReasonCode string `json:"reason_code,omitempty"`
+	// ActualName is the name of the account holder returned for close matches.
+	ActualName string `json:"actual_name,omitempty"`
+}
@@ -27,4 +27,90 @@ const (
 	CurrencyGBP = "GBP"
 	CurrencyEUR = "EUR"
 	CurrencyUSD = "USD"
+
+	PaymentSchemeFPS  = "FPS"
+	PaymentSchemeBacs = "Bacs"
+	PaymentSchemeSEPA = "SEPACT"
+
+	SchemePaymentTypeImmediatePayment    = "ImmediatePayment"
+	SchemePaymentTypeForwardDatedPayment = "ForwardDatedPayment"
+	SchemePaymentTypeStandingOrder       = "StandingOrder"
+
+	SubmissionStatusAccepted          = "accepted"
+	SubmissionStatusValidationPending = "validation_pending"
+	SubmissionStatusDeliveryConfirmed = "delivery_confirmed"
+	SubmissionStatusDeliveryFailed    = "delivery_failed"
+	SubmissionStatusSubmitted         = "submitted"
+
+	AdmissionStatusConfirmed = "confirmed"
+	AdmissionStatusFailed    = "failed"
+
+	ReturnCodeIncorrectAccountNumber  = "AC01"
+	ReturnCodeClosedAccountNumber     = "AC04"
+	ReturnCodeBlockedAccount          = "AC06"
+	ReturnCodeTransactionForbidden    = "AG01"
+	ReturnCodeDuplication             = "AM05"
+	ReturnCodeInconsistentWithEndUser = "BE01"
+	ReturnCodeNotSpecifiedReason      = "MS03"
+	ReturnCodeRegulatoryReason        = "RR04"
+
+	ReversalCodeDuplication          = "AM05"
+	ReversalCodeIncorrectAmount      = "AM09"
+	ReversalCodeNotSpecifiedCustomer = "MS02"
+	ReversalCodeNotSpecifiedAgent    = "MS03"
+
+	RecallReasonCodeDuplicatePayment    = "DUPL"
+	RecallReasonCodeTechnicalProblem    = "TECH"
+	RecallReasonCodeFraudulentOrigin    = "FRAD"
+	RecallReasonCodeRequestedByCustomer = "CUST"
+	RecallReasonCodeWrongAccount        = "AC03"
+	RecallReasonCodeWrongAmount         = "AM09"
+
+	AccountClassificationPersonal = "Personal"
+	AccountClassificationBusiness = "Business"
+
+	AccountNumberCodeBBAN = "BBAN"
+	AccountNumberCodeIBAN = "IBAN"
+
+	AccountTypePersonal = 0
+	AccountTypeBusiness = 1
+
+	CopReasonCodeNoMatch                   = "ANNM"
+	CopReasonCodeCloseMatch                = "MBAM"
+	CopReasonCodeBusinessNameMatch         = "BANM"
+	CopReasonCodePersonalNameMatch         = "PANM"
+	CopReasonCodeBusinessCloseMatch        = "BAMM"
+	CopReasonCodePersonalCloseMatch        = "PAMM"
+	CopReasonCodeAccountNotFound           = "AC01"
+	CopReasonCodeInvalidSecondaryReference = "IVCR"
+	CopReasonCodeAccountNotSupported       = "ACNS"
+	CopReasonCodeOptedOut                  = "OPTO"
+	CopReasonCodeAccountSwitched           = "CASS"
+	CopReasonCodeSortCodeNotSupported      = "SCNS"
+
+	CallbackTransportHTTP  = "http"
+	CallbackTransportQueue = "queue"
+
+	EventTypeCreated = "created"
+	EventTypeUpdated = "updated"
+	EventTypeDeleted = "deleted"
+
+	RecordTypeAccounts               = "accounts"
+	RecordTypePayments               = "payments"
+	RecordTypePaymentSubmissions     = "payment_submissions"
+	RecordTypePaymentAdmissions      = "payment_admissions"
+	RecordTypeReturns                = "returns"
+	RecordTypeReturnSubmissions      = "return_submissions"
+	RecordTypeReturnAdmissions       = "return_admissions"
+	RecordTypeReversals              = "reversals"
+	RecordTypeReversalSubmissions    = "reversal_submissions"
+	RecordTypeReversalAdmissions     = "reversal_admissions"
+	RecordTypeRecalls                = "recalls"
+	RecordTypeRecallSubmissions      = "recall_submissions"
+	RecordTypeRecallAdmissions       = "recall_admissions"
+	RecordTypeDirectDebits           = "direct_debits"
+	RecordTypeDirectDebitSubmissions = "direct_debit_submissions"
+	RecordTypeMandates               = "mandates"
+	RecordTypeMandateSubmissions     = "mandate_submissions"
+	RecordTypeMandateCancellations   = "mandate_cancellations"
 )
@@ -0,0 +1,26 @@
+package models
+
+type DirectDebitResource struct {
+	Resource
+	Attributes *DirectDebitAttributes `json:"attributes,omitempty"`
+}
+
+type DirectDebitAttributes struct {
+	MandateReference
+	Amount               string        `json:"amount,omitempty"`
+	Currency             string        `json:"currency,omitempty"`
+	EndToEndReference    string        `json:"end_to_end_reference,omitempty"`
+	NumericReference     string        `json:"numeric_reference,omitempty"`
+	Originator           *Originator   `json:"beneficiary_party,omitempty"`
+	PayerAccount         *PayerAccount `json:"debtor_party,omitempty"`
+	PaymentScheme        string        `json:"payment_scheme,omitempty"`
+	ProcessingDate       string        `json:"processing_date,omitempty"`
+	SchemePaymentSubType string        `json:"scheme_payment_sub_type,omitempty"`
+	SchemePaymentType    string        `json:"scheme_payment_type,omitempty"`
+	UniqueSchemeID       string        `json:"unique_scheme_id,omitempty"`
+}
+
+type DirectDebitSubmissionResource struct {
+	Resource
+	Attributes *SubmissionAttributes `json:"attributes,omitempty"`
+}
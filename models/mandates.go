@@ -0,0 +1,60 @@
+package models
+
+type MandateResource struct {
+	Resource
+	Attributes *MandateAttributes `json:"attributes,omitempty"`
+}
+
+type MandateAttributes struct {
+	MandateReference
+	Originator     *Originator   `json:"beneficiary_party,omitempty"`
+	PayerAccount   *PayerAccount `json:"debtor_party,omitempty"`
+	PaymentScheme  string        `json:"payment_scheme,omitempty"`
+	ProcessingDate string        `json:"processing_date,omitempty"`
+}
+
+// MandateReference identifies the mandate under which the originator collects direct debits from the payer.
+type MandateReference struct {
+	// Reference is the reference of the mandate quoted on every collection (Bacs DDI reference).
+	Reference string `json:"reference,omitempty"`
+}
+
+// Originator is the party collecting direct debits (the service user in Bacs).
+type Originator struct {
+	AccountName       string `json:"account_name,omitempty"`
+	AccountNumber     string `json:"account_number,omitempty"`
+	AccountNumberCode string `json:"account_number_code,omitempty"`
+	BankID            string `json:"bank_id,omitempty"`
+	BankIDCode        string `json:"bank_id_code,omitempty"`
+	Name              string `json:"name,omitempty"`
+	// OriginatorID identifies the originator in the scheme (service user number in Bacs).
+	OriginatorID string `json:"originator_id,omitempty"`
+}
+
+// PayerAccount is the account direct debits are collected from.
+type PayerAccount struct {
+	AccountName       string   `json:"account_name,omitempty"`
+	AccountNumber     string   `json:"account_number,omitempty"`
+	AccountNumberCode string   `json:"account_number_code,omitempty"`
+	AccountType       *int     `json:"account_type,omitempty"`
+	Address           []string `json:"address,omitempty"`
+	BankID            string   `json:"bank_id,omitempty"`
+	BankIDCode        string   `json:"bank_id_code,omitempty"`
+	Country           string   `json:"country,omitempty"`
+	Name              string   `json:"name,omitempty"`
+}
+
+type MandateSubmissionResource struct {
+	Resource
+	Attributes *SubmissionAttributes `json:"attributes,omitempty"`
+}
+
+type MandateCancellationResource struct {
+	Resource
+	Attributes *MandateCancellationAttributes `json:"attributes,omitempty"`
+}
+
+type MandateCancellationAttributes struct {
+	Reason     string `json:"reason,omitempty"`
+	ReasonCode string `json:"reason_code,omitempty"`
+}
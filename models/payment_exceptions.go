@@ -0,0 +1,61 @@
+package models
+
+type ReturnResource struct {
+	Resource
+	Attributes *ReturnAttributes `json:"attributes,omitempty"`
+}
+
+type ReturnAttributes struct {
+	Amount     string `json:"amount,omitempty"`
+	Currency   string `json:"currency,omitempty"`
+	ReturnCode string `json:"return_code,omitempty"`
+}
+
+type ReturnSubmissionResource struct {
+	Resource
+	Attributes *SubmissionAttributes `json:"attributes,omitempty"`
+}
+
+type ReturnAdmissionResource struct {
+	Resource
+	Attributes *AdmissionAttributes `json:"attributes,omitempty"`
+}
+
+type ReversalResource struct {
+	Resource
+	Attributes *ReversalAttributes `json:"attributes,omitempty"`
+}
+
+type ReversalAttributes struct {
+	ReversalCode string `json:"reversal_code,omitempty"`
+}
+
+type ReversalSubmissionResource struct {
+	Resource
+	Attributes *SubmissionAttributes `json:"attributes,omitempty"`
+}
+
+type ReversalAdmissionResource struct {
+	Resource
+	Attributes *AdmissionAttributes `json:"attributes,omitempty"`
+}
+
+type RecallResource struct {
+	Resource
+	Attributes *RecallAttributes `json:"attributes,omitempty"`
+}
+
+type RecallAttributes struct {
+	Reason     string `json:"reason,omitempty"`
+	ReasonCode string `json:"reason_code,omitempty"`
+}
+
+type RecallSubmissionResource struct {
+	Resource
+	Attributes *SubmissionAttributes `json:"attributes,omitempty"`
+}
+
+type RecallAdmissionResource struct {
+	Resource
+	Attributes *AdmissionAttributes `json:"attributes,omitempty"`
+}
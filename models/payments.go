@@ -0,0 +1,40 @@
+package models
+
+type PaymentResource struct {
+	Resource
+	Attributes *PaymentAttributes `json:"attributes,omitempty"`
+}
+
+type PaymentAttributes struct {
+	Amount               string        `json:"amount,omitempty"`
+	BeneficiaryParty     *PaymentParty `json:"beneficiary_party,omitempty"`
+	Currency             string        `json:"currency,omitempty"`
+	DebtorParty          *PaymentParty `json:"debtor_party,omitempty"`
+	EndToEndReference    string        `json:"end_to_end_reference,omitempty"`
+	NumericReference     string        `json:"numeric_reference,omitempty"`
+	PaymentPurpose       string        `json:"payment_purpose,omitempty"`
+	PaymentScheme        string        `json:"payment_scheme,omitempty"`
+	PaymentType          string        `json:"payment_type,omitempty"`
+	ProcessingDate       string        `json:"processing_date,omitempty"`
+	Reference            string        `json:"reference,omitempty"`
+	SchemePaymentSubType string        `json:"scheme_payment_sub_type,omitempty"`
+	SchemePaymentType    string        `json:"scheme_payment_type,omitempty"`
+	UniqueSchemeID       string        `json:"unique_scheme_id,omitempty"`
+}
+
+type PaymentParty struct {
+	AccountName       string   `json:"account_name,omitempty"`
+	AccountNumber     string   `json:"account_number,omitempty"`
+	AccountNumberCode string   `json:"account_number_code,omitempty"`
+	AccountType       *int     `json:"account_type,omitempty"`
+	Address           []string `json:"address,omitempty"`
+	BankID            string   `json:"bank_id,omitempty"`
+	BankIDCode        string   `json:"bank_id_code,omitempty"`
+	Country           string   `json:"country,omitempty"`
+	Name              string   `json:"name,omitempty"`
+}
+
+type PaymentSubmissionResource struct {
+	Resource
+	Attributes *SubmissionAttributes `json:"attributes,omitempty"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type SubmissionAttributes struct {
+	SchemeStatusCode            string     `json:"scheme_status_code,omitempty"`
+	SchemeStatusCodeDescription string     `json:"scheme_status_code_description,omitempty"`
+	SettlementCycle             *int       `json:"settlement_cycle,omitempty"`
+	SettlementDate              string     `json:"settlement_date,omitempty"`
+	Status                      string     `json:"status,omitempty"`
+	StatusReason                string     `json:"status_reason,omitempty"`
+	SubmissionDatetime          *time.Time `json:"submission_datetime,omitempty"`
+}
@@ -0,0 +1,15 @@
+package models
+
+type SubscriptionResource struct {
+	Resource
+	Attributes *SubscriptionAttributes `json:"attributes,omitempty"`
+}
+
+type SubscriptionAttributes struct {
+	CallbackTransport string `json:"callback_transport,omitempty"`
+	CallbackURI       string `json:"callback_uri,omitempty"`
+	Deactivated       *bool  `json:"deactivated,omitempty"`
+	EventType         string `json:"event_type,omitempty"`
+	RecordType        string `json:"record_type,omitempty"`
+	UserID            string `json:"user_id,omitempty"`
+}
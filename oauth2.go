@@ -0,0 +1,142 @@
+package form3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// OAuth2TokenPath is the path of the endpoint issuing OAuth2 access tokens.
+	OAuth2TokenPath = "/v1/oauth2/token"
+
+	// OAuth2RefreshMargin is how long before the expiry a cached access token is refreshed.
+	// Tokens valid for less than twice the margin are refreshed halfway through their lifetime instead.
+	OAuth2RefreshMargin = 30 * time.Second
+
+	// OAuth2DefaultTokenLifetime is how long an access token is assumed to be valid if the token endpoint omits `expires_in`.
+	OAuth2DefaultTokenLifetime = 5 * time.Minute
+)
+
+// OAuth2Authenticator is an Authenticator that obtains bearer tokens from the OAuth2 token endpoint using client credentials.
+// Tokens are cached until shortly before they expire and refreshed when the API rejects them. It is safe for concurrent use.
+type OAuth2Authenticator struct {
+	tokenUrl     string
+	clientID     string
+	clientSecret string
+	httpClient   *http.Client
+	now          func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	refresh time.Time
+}
+
+type oauth2Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// NewOAuth2Authenticator creates a new OAuth2Authenticator that fetches tokens from OAuth2TokenPath relative to the given base URL.
+func NewOAuth2Authenticator(baseUrl, clientID, clientSecret string) *OAuth2Authenticator {
+	return &OAuth2Authenticator{
+		tokenUrl:     strings.TrimSuffix(baseUrl, "/") + OAuth2TokenPath,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		httpClient: &http.Client{
+			Timeout: DefaultHttpTimeout,
+		},
+		now: time.Now,
+	}
+}
+
+// SetHttpClient configures the http.Client used to access the token endpoint.
+func (a *OAuth2Authenticator) SetHttpClient(v *http.Client) *OAuth2Authenticator {
+	a.httpClient = v
+	return a
+}
+
+// Authenticate sets the `Authorization: Bearer` header of the request.
+func (a *OAuth2Authenticator) Authenticate(req *http.Request, _ []byte) error {
+	token, err := a.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// Token returns the cached access token or fetches a new one if it is missing or about to expire.
+func (a *OAuth2Authenticator) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && a.now().Before(a.refresh) {
+		return a.token, nil
+	}
+
+	token, err := a.fetchToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	a.token = token.AccessToken
+	a.refresh = a.now().Add(refreshAfter(time.Duration(token.ExpiresIn) * time.Second))
+
+	return a.token, nil
+}
+
+// refreshAfter returns how long a token with the given lifetime is cached.
+func refreshAfter(lifetime time.Duration) time.Duration {
+	if lifetime <= 0 {
+		lifetime = OAuth2DefaultTokenLifetime
+	}
+	if lifetime < 2*OAuth2RefreshMargin {
+		return lifetime / 2
+	}
+	return lifetime - OAuth2RefreshMargin
+}
+
+// Invalidate discards the cached access token if the request was authenticated with it.
+func (a *OAuth2Authenticator) Invalidate(req *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if req.Header.Get("Authorization") == "Bearer "+a.token {
+		a.token = ""
+	}
+}
+
+func (a *OAuth2Authenticator) fetchToken(ctx context.Context) (*oauth2Token, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, "POST", a.tokenUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(a.clientID), url.QueryEscape(a.clientSecret))
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorFromResponse(resp); err != nil {
+		return nil, err
+	}
+	defer drainBody(resp)
+
+	token := &oauth2Token{}
+	if err = json.NewDecoder(resp.Body).Decode(token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("token endpoint returned no access token")
+	}
+
+	return token, nil
+}
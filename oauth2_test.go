@@ -0,0 +1,224 @@
+package form3_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3"
+)
+
+// oauth2Server issues sequentially numbered tokens and serves /v1/resource, accepting only tokens for which `valid` returns true.
+func oauth2Server(t *testing.T, expiresIn int, valid func(token string) bool) (*httptest.Server, *int32) {
+	var issued int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "client-id" || secret != "client-secret" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"Bad credentials"}`))
+			return
+		}
+
+		n := atomic.AddInt32(&issued, 1)
+		_, _ = fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"bearer","expires_in":%d}`, n, expiresIn)
+	})
+	mux.HandleFunc("/v1/resource", func(w http.ResponseWriter, r *http.Request) {
+		var token string
+		_, _ = fmt.Sscanf(r.Header.Get("Authorization"), "Bearer %s", &token)
+		if !valid(token) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		_, _ = fmt.Fprintf(w, `{"data":{"token":"%s","body":%q}}`, token, body)
+	})
+	return httptest.NewServer(mux), &issued
+}
+
+type tokenResponse struct {
+	Token string `json:"token"`
+	Body  string `json:"body"`
+}
+
+func TestOAuth2Authenticator(t *testing.T) {
+	anyToken := func(token string) bool { return token != "" }
+
+	t.Run("token is cached", func(t *testing.T) {
+		ts, issued := oauth2Server(t, 3600, anyToken)
+		defer ts.Close()
+
+		api := form3.New().
+			SetBaseUrl(ts.URL).
+			SetAuthenticator(form3.NewOAuth2Authenticator(ts.URL, "client-id", "client-secret")).
+			Api()
+
+		for i := 0; i < 3; i++ {
+			var resp tokenResponse
+			err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource", Response: &resp})
+			require.NoError(t, err)
+			assert.Equal(t, "token-1", resp.Token)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(issued))
+	})
+
+	t.Run("token is refreshed before expiry", func(t *testing.T) {
+		ts, issued := oauth2Server(t, 3600, anyToken)
+		defer ts.Close()
+
+		auth := form3.NewOAuth2Authenticator(ts.URL, "client-id", "client-secret")
+		now := time.Now()
+		form3.SetOAuth2Clock(auth, func() time.Time { return now })
+
+		for _, d := range []time.Duration{0, time.Hour - form3.OAuth2RefreshMargin - time.Second, 2 * time.Second} {
+			now = now.Add(d)
+			_, err := auth.Token(context.Background())
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(issued))
+	})
+
+	t.Run("missing expiry uses default lifetime", func(t *testing.T) {
+		ts, issued := oauth2Server(t, 0, anyToken)
+		defer ts.Close()
+
+		auth := form3.NewOAuth2Authenticator(ts.URL, "client-id", "client-secret")
+		now := time.Now()
+		form3.SetOAuth2Clock(auth, func() time.Time { return now })
+
+		for _, d := range []time.Duration{0, time.Second, form3.OAuth2DefaultTokenLifetime - form3.OAuth2RefreshMargin - 2*time.Second, 2 * time.Second} {
+			now = now.Add(d)
+			_, err := auth.Token(context.Background())
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(issued))
+	})
+
+	t.Run("short-lived token is cached for half its lifetime", func(t *testing.T) {
+		ts, issued := oauth2Server(t, 10, anyToken)
+		defer ts.Close()
+
+		auth := form3.NewOAuth2Authenticator(ts.URL, "client-id", "client-secret")
+		now := time.Now()
+		form3.SetOAuth2Clock(auth, func() time.Time { return now })
+
+		for _, d := range []time.Duration{0, 4 * time.Second, 2 * time.Second} {
+			now = now.Add(d)
+			_, err := auth.Token(context.Background())
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(issued))
+	})
+
+	t.Run("rejected token is refreshed once", func(t *testing.T) {
+		ts, issued := oauth2Server(t, 3600, func(token string) bool { return token == "token-2" })
+		defer ts.Close()
+
+		api := form3.New().
+			SetBaseUrl(ts.URL).
+			SetAuthenticator(form3.NewOAuth2Authenticator(ts.URL, "client-id", "client-secret")).
+			Api()
+
+		var resp tokenResponse
+		err := api.Do(context.Background(), &form3.Call{
+			Method:   "POST",
+			Path:     "/v1/resource",
+			Request:  map[string]string{"id": "123"},
+			Response: &resp,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "token-2", resp.Token)
+		assert.Equal(t, `{"data":{"id":"123"}}`, resp.Body)
+		assert.Equal(t, int32(2), atomic.LoadInt32(issued))
+	})
+
+	t.Run("token is refreshed only once", func(t *testing.T) {
+		ts, issued := oauth2Server(t, 3600, func(token string) bool { return false })
+		defer ts.Close()
+
+		var attempts []int
+		api := form3.New().
+			SetBaseUrl(ts.URL).
+			SetAuthenticator(form3.NewOAuth2Authenticator(ts.URL, "client-id", "client-secret")).
+			AddAttemptHook(func(ctx context.Context, a *form3.Attempt) { attempts = append(attempts, a.Index) }).
+			Api()
+
+		err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+		assert.ErrorContains(t, err, "HTTP 401")
+		assert.Equal(t, int32(2), atomic.LoadInt32(issued))
+
+		var callErr form3.CallError
+		require.ErrorAs(t, err, &callErr)
+		assert.Equal(t, 2, callErr.Attempts, "the request with the refreshed token is an attempt")
+		assert.Equal(t, []int{0, 1}, attempts)
+	})
+
+	t.Run("rejected credentials are not refreshed", func(t *testing.T) {
+		var requests int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, form3.OAuth2TokenPath, r.URL.Path)
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer ts.Close()
+
+		api := form3.New().
+			SetBaseUrl(ts.URL).
+			SetAuthenticator(form3.NewOAuth2Authenticator(ts.URL, "client-id", "client-secret")).
+			Api()
+
+		err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+		assert.ErrorIs(t, err, form3.ErrUnauthorized)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	})
+
+	t.Run("concurrent callers share the token", func(t *testing.T) {
+		ts, issued := oauth2Server(t, 3600, anyToken)
+		defer ts.Close()
+
+		api := form3.New().
+			SetBaseUrl(ts.URL).
+			SetAuthenticator(form3.NewOAuth2Authenticator(ts.URL, "client-id", "client-secret")).
+			Api()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), atomic.LoadInt32(issued))
+	})
+
+	t.Run("invalid client credentials", func(t *testing.T) {
+		ts, _ := oauth2Server(t, 3600, anyToken)
+		defer ts.Close()
+
+		api := form3.New().
+			SetBaseUrl(ts.URL).
+			SetBackOffProvider(testBackOff(0)).
+			SetAuthenticator(form3.NewOAuth2Authenticator(ts.URL, "client-id", "wrong")).
+			Api()
+
+		err := api.Do(context.Background(), &form3.Call{Method: "GET", Path: "/v1/resource"})
+		var e form3.Error
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, "invalid_client: Bad credentials", e.Error())
+	})
+}
@@ -1,6 +1,9 @@
 package form3
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 // SetBaseUrl configures the base URL of the Form3 API.
 func (c *Client) SetBaseUrl(v string) *Client {
@@ -31,3 +34,55 @@ func (c *Client) SetBackOffProvider(v func() BackOff) *Client {
 	c.backOffProvider = v
 	return c
 }
+
+// SetRetryPolicy configures the RetryPolicy that decides which failed attempts are retried.
+func (c *Client) SetRetryPolicy(v RetryPolicy) *Client {
+	c.retryPolicy = v
+	return c
+}
+
+// SetCircuitBreaker configures the CircuitBreaker shared by all API calls of the client. Nil disables it.
+func (c *Client) SetCircuitBreaker(v *CircuitBreaker) *Client {
+	c.circuitBreaker = v
+	return c
+}
+
+// SetRateLimiter configures the RateLimiter shared by all API calls of the client. Every attempt waits for its turn. Nil disables it.
+func (c *Client) SetRateLimiter(v *RateLimiter) *Client {
+	c.rateLimiter = v
+	return c
+}
+
+// SetAttemptTimeout configures the timeout of a single attempt to perform an API call, separate from the context deadline of the whole call.
+// Attempts exceeding the timeout fail with ErrAttemptTimeout and may be retried. Zero disables the timeout.
+func (c *Client) SetAttemptTimeout(v time.Duration) *Client {
+	c.attemptTimeout = v
+	return c
+}
+
+// SetAuthenticator configures the Authenticator that adds credentials to every API request attempt, such as HttpSigner.
+func (c *Client) SetAuthenticator(v Authenticator) *Client {
+	c.authenticator = v
+	return c
+}
+
+// AddMiddleware registers middlewares wrapping every API call. The first registered middleware is the outermost one.
+// Every Middleware is called once here, so state it sets up is shared by all API calls.
+func (c *Client) AddMiddleware(v ...Middleware) *Client {
+	for _, m := range v {
+		link := &apiLink{next: c.api}
+		if c.middlewareTail == nil {
+			c.middlewareHead = m(link)
+		} else {
+			c.middlewareTail.next = m(link)
+		}
+		c.middlewareTail = link
+	}
+	return c
+}
+
+// AddAttemptHook registers hooks invoked after every attempt to perform an API call, including retries.
+func (c *Client) AddAttemptHook(v ...AttemptHook) *Client {
+	c.attemptHooks = append(c.attemptHooks, v...)
+	return c
+}
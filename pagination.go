@@ -0,0 +1,224 @@
+package form3
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// Query parameters used by list endpoints for pagination.
+const (
+	pageNumberParam = "page[number]"
+	pageSizeParam   = "page[size]"
+)
+
+// dateLayout is the layout of dates (such as processing dates) used in filters.
+const dateLayout = "2006-01-02"
+
+// validateProcessingDates checks the optional processing date range of a filter of the given resource name.
+func validateProcessingDates(name, from, to string) error {
+	var fromDate, toDate time.Time
+	var err error
+	if from != "" {
+		if fromDate, err = time.Parse(dateLayout, from); err != nil {
+			return fmt.Errorf("%w: invalid %s filter: filter[processing_date_from]: %v", ErrValidation, name, err)
+		}
+	}
+	if to != "" {
+		if toDate, err = time.Parse(dateLayout, to); err != nil {
+			return fmt.Errorf("%w: invalid %s filter: filter[processing_date_to]: %v", ErrValidation, name, err)
+		}
+	}
+	if !fromDate.IsZero() && !toDate.IsZero() && fromDate.After(toDate) {
+		return fmt.Errorf("%w: invalid %s filter: filter[processing_date_from] is after filter[processing_date_to]", ErrValidation, name)
+	}
+	return nil
+}
+
+// ListOptions configures pagination of list endpoints.
+type ListOptions struct {
+	// PageNumber is the zero-based number of the page to fetch.
+	PageNumber int
+	// PageSize is the maximum number of resources per page. The API default is used if zero.
+	PageSize int
+}
+
+func (o *ListOptions) queryParams() url.Values {
+	params := url.Values{}
+	if o == nil {
+		return params
+	}
+	if o.PageNumber > 0 {
+		params.Set(pageNumberParam, strconv.Itoa(o.PageNumber))
+	}
+	if o.PageSize > 0 {
+		params.Set(pageSizeParam, strconv.Itoa(o.PageSize))
+	}
+	return params
+}
+
+// filter is implemented by typed filters of list endpoints.
+type filter interface {
+	queryParams() (url.Values, error)
+}
+
+// filterParams returns `filter[<field>]` query parameters for non-empty values of the given fields.
+func filterParams(fields map[string]string) url.Values {
+	params := url.Values{}
+	for field, value := range fields {
+		if value != "" {
+			params.Set(fmt.Sprintf("filter[%s]", field), value)
+		}
+	}
+	return params
+}
+
+// listParams combines query parameters of the filter and the pagination options.
+func listParams(f filter, opts *ListOptions) (url.Values, error) {
+	params, err := f.queryParams()
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range opts.queryParams() {
+		params[k] = v
+	}
+	return params, nil
+}
+
+// Page is a single page of resources returned by a list endpoint.
+type Page[T any] struct {
+	// Items are the resources on the page.
+	Items []T
+	// Links are the pagination links returned with the page.
+	Links models.Links
+}
+
+// HasNext reports whether the API has more pages after this one.
+func (p *Page[T]) HasNext() bool {
+	if p.Links.Next == "" {
+		return false
+	}
+	return p.Links.Self == "" || p.Links.Self != p.Links.Last
+}
+
+// nextParams returns query parameters of the next page by merging the query of `links.next` into the current ones.
+// Returns nil if there is no next page.
+func (p *Page[T]) nextParams(params url.Values) (url.Values, error) {
+	if len(p.Items) == 0 || !p.HasNext() {
+		return nil, nil
+	}
+	next, err := url.Parse(p.Links.Next)
+	if err != nil {
+		return nil, err
+	}
+
+	result := url.Values{}
+	for k, v := range params {
+		result[k] = v
+	}
+	for k, v := range next.Query() {
+		result[k] = v
+	}
+	return result, nil
+}
+
+func listPage[T any](ctx context.Context, c *Client, path string, params url.Values) (*Page[T], error) {
+	page := &Page[T]{}
+	call := &Call{
+		Method:      "GET",
+		Path:        path,
+		QueryParams: params,
+		Response:    &page.Items,
+		Links:       &page.Links,
+	}
+	if err := c.Api().Do(ctx, call); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// Iterator lazily fetches pages of a list endpoint and iterates over their resources.
+//
+//	it := client.Accounts().Iter(ctx, nil, &form3.ListOptions{PageSize: 100})
+//	for it.Next() {
+//		account := it.Value()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type Iterator[T any] struct {
+	ctx    context.Context
+	fetch  func(ctx context.Context, params url.Values) (*Page[T], error)
+	params url.Values
+	page   *Page[T]
+	index  int
+	err    error
+}
+
+func newIterator[T any](ctx context.Context, params url.Values, fetch func(ctx context.Context, params url.Values) (*Page[T], error)) *Iterator[T] {
+	return &Iterator[T]{
+		ctx:    ctx,
+		fetch:  fetch,
+		params: params,
+	}
+}
+
+// newFailedIterator returns an Iterator that yields no resources and reports the given error.
+func newFailedIterator[T any](err error) *Iterator[T] {
+	return &Iterator[T]{err: err}
+}
+
+// NewStaticIterator returns an Iterator over the given resources that reports err, if any, once they are exhausted.
+// It does not call the API and is meant for stubbing Iter methods of mocked clients.
+func NewStaticIterator[T any](items []T, err error) *Iterator[T] {
+	it := &Iterator[T]{ctx: context.Background(), page: &Page[T]{Items: items}, index: -1}
+	if err != nil {
+		it.params = url.Values{}
+		it.fetch = func(context.Context, url.Values) (*Page[T], error) {
+			return nil, err
+		}
+	}
+	return it
+}
+
+// Next advances the iterator to the next resource, fetching the next page if the current one is exhausted.
+// It returns false when there are no more resources, the context is cancelled, or the API returns an error.
+func (it *Iterator[T]) Next() bool {
+	for it.err == nil {
+		if it.page != nil && it.index+1 < len(it.page.Items) {
+			it.index++
+			return true
+		}
+		if it.params == nil {
+			return false
+		}
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		page, err := it.fetch(it.ctx, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.index = page, -1
+		it.params, it.err = page.nextParams(it.params)
+	}
+	return false
+}
+
+// Value returns the current resource. Should only be called after Next returned true.
+func (it *Iterator[T]) Value() T {
+	return it.page.Items[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
@@ -0,0 +1,144 @@
+package form3_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3"
+	"mkuznets.com/go/form3/models"
+)
+
+// pagedAccountsHandler serves `total` accounts split into pages of `size` resources.
+func pagedAccountsHandler(t *testing.T, total, size int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		number, err := strconv.Atoi(r.URL.Query().Get("page[number]"))
+		if err != nil {
+			number = 0
+		}
+		assert.Equal(t, strconv.Itoa(size), r.URL.Query().Get("page[size]"))
+
+		var items []string
+		for i := number * size; i < total && i < (number+1)*size; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"%d"}`, i))
+		}
+		last := (total - 1) / size
+
+		link := func(n int) string {
+			return fmt.Sprintf(`"/v1/organisation/accounts?page%%5Bnumber%%5D=%d&page%%5Bsize%%5D=%d"`, n, size)
+		}
+		next := ""
+		if number < last {
+			next = fmt.Sprintf(`"next":%s,`, link(number+1))
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"links":{%s"self":%s,"last":%s}}`,
+			strings.Join(items, ","), next, link(number), link(last))
+	}
+}
+
+func TestIterator(t *testing.T) {
+	t.Run("all pages", func(t *testing.T) {
+		handler := pagedAccountsHandler(t, 7, 3)
+		requests := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			handler(w, r)
+		}))
+		defer ts.Close()
+
+		client := form3.New().SetBaseUrl(ts.URL)
+		it := client.Accounts().Iter(context.Background(), nil, &form3.ListOptions{PageSize: 3})
+
+		var ids []string
+		for it.Next() {
+			ids = append(ids, it.Value().ID)
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, []string{"0", "1", "2", "3", "4", "5", "6"}, ids)
+		assert.Equal(t, 3, requests)
+	})
+
+	t.Run("starting page", func(t *testing.T) {
+		ts := httptest.NewServer(pagedAccountsHandler(t, 7, 3))
+		defer ts.Close()
+
+		client := form3.New().SetBaseUrl(ts.URL)
+		it := client.Accounts().Iter(context.Background(), nil, &form3.ListOptions{PageNumber: 1, PageSize: 3})
+
+		var ids []string
+		for it.Next() {
+			ids = append(ids, it.Value().ID)
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, []string{"3", "4", "5", "6"}, ids)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":[],"links":{"self":"/v1/organisation/accounts"}}`))
+		}))
+		defer ts.Close()
+
+		client := form3.New().SetBaseUrl(ts.URL)
+		it := client.Accounts().Iter(context.Background(), nil, nil)
+		assert.False(t, it.Next())
+		assert.NoError(t, it.Err())
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		ts := httptest.NewServer(pagedAccountsHandler(t, 7, 3))
+		defer ts.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		client := form3.New().SetBaseUrl(ts.URL)
+		it := client.Accounts().Iter(ctx, nil, &form3.ListOptions{PageSize: 3})
+
+		var ids []string
+		for it.Next() {
+			ids = append(ids, it.Value().ID)
+			cancel()
+		}
+		assert.ErrorIs(t, it.Err(), context.Canceled)
+		assert.Equal(t, []string{"0", "1", "2"}, ids)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer ts.Close()
+
+		client := form3.New().SetBaseUrl(ts.URL)
+		it := client.Accounts().Iter(context.Background(), nil, nil)
+		assert.False(t, it.Next())
+		assert.ErrorContains(t, it.Err(), "HTTP 400")
+	})
+}
+
+func TestPage_HasNext(t *testing.T) {
+	tests := []struct {
+		name  string
+		links models.Links
+		want  bool
+	}{
+		{name: "no links", want: false},
+		{name: "next", links: models.Links{Next: "/next"}, want: true},
+		{name: "next, not last", links: models.Links{Next: "/next", Self: "/self", Last: "/last"}, want: true},
+		{name: "last", links: models.Links{Next: "/next", Self: "/last", Last: "/last"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := &form3.Page[*models.AccountResource]{Links: tt.links}
+			assert.Equal(t, tt.want, page.HasNext())
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package form3
+
+import (
+	"context"
+	"path"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// ReturnsClient is the Form3 API client for returns of transactions, such as /v1/transaction/payments/{id}/returns endpoints.
+type ReturnsClient interface {
+	// Create a return of an inbound transaction.
+	Create(ctx context.Context, transactionID string, attributes *models.ReturnAttributes) (*models.ReturnResource, error)
+	// Fetch a single Return resource using the transaction ID and the return ID.
+	Fetch(ctx context.Context, transactionID, returnID string) (*models.ReturnResource, error)
+	// CreateSubmission submits the return to the payment scheme.
+	CreateSubmission(ctx context.Context, transactionID, returnID string) (*models.ReturnSubmissionResource, error)
+	// FetchSubmission fetches a single Return Submission resource using the transaction ID, the return ID and the submission ID.
+	FetchSubmission(ctx context.Context, transactionID, returnID, submissionID string) (*models.ReturnSubmissionResource, error)
+	// CreateAdmission admits a return received for an outbound transaction.
+	CreateAdmission(ctx context.Context, transactionID, returnID string) (*models.ReturnAdmissionResource, error)
+	// FetchAdmission fetches a single Return Admission resource using the transaction ID, the return ID and the admission ID.
+	FetchAdmission(ctx context.Context, transactionID, returnID, admissionID string) (*models.ReturnAdmissionResource, error)
+}
+
+// ReversalsClient is the Form3 API client for reversals of transactions, such as /v1/transaction/payments/{id}/reversals endpoints.
+type ReversalsClient interface {
+	// Create a reversal of an outbound transaction.
+	Create(ctx context.Context, transactionID string, attributes *models.ReversalAttributes) (*models.ReversalResource, error)
+	// Fetch a single Reversal resource using the transaction ID and the reversal ID.
+	Fetch(ctx context.Context, transactionID, reversalID string) (*models.ReversalResource, error)
+	// CreateSubmission submits the reversal to the payment scheme.
+	CreateSubmission(ctx context.Context, transactionID, reversalID string) (*models.ReversalSubmissionResource, error)
+	// FetchSubmission fetches a single Reversal Submission resource using the transaction ID, the reversal ID and the submission ID.
+	FetchSubmission(ctx context.Context, transactionID, reversalID, submissionID string) (*models.ReversalSubmissionResource, error)
+	// CreateAdmission admits a reversal received for an inbound transaction.
+	CreateAdmission(ctx context.Context, transactionID, reversalID string) (*models.ReversalAdmissionResource, error)
+	// FetchAdmission fetches a single Reversal Admission resource using the transaction ID, the reversal ID and the admission ID.
+	FetchAdmission(ctx context.Context, transactionID, reversalID, admissionID string) (*models.ReversalAdmissionResource, error)
+}
+
+// RecallsClient is the Form3 API client for /v1/transaction/payments/{id}/recalls endpoints.
+type RecallsClient interface {
+	// Create a recall of an outbound payment.
+	Create(ctx context.Context, transactionID string, attributes *models.RecallAttributes) (*models.RecallResource, error)
+	// Fetch a single Recall resource using the transaction ID and the recall ID.
+	Fetch(ctx context.Context, transactionID, recallID string) (*models.RecallResource, error)
+	// CreateSubmission submits the recall to the payment scheme.
+	CreateSubmission(ctx context.Context, transactionID, recallID string) (*models.RecallSubmissionResource, error)
+	// FetchSubmission fetches a single Recall Submission resource using the transaction ID, the recall ID and the submission ID.
+	FetchSubmission(ctx context.Context, transactionID, recallID, submissionID string) (*models.RecallSubmissionResource, error)
+	// CreateAdmission admits a recall received for an inbound payment.
+	CreateAdmission(ctx context.Context, transactionID, recallID string) (*models.RecallAdmissionResource, error)
+	// FetchAdmission fetches a single Recall Admission resource using the transaction ID, the recall ID and the admission ID.
+	FetchAdmission(ctx context.Context, transactionID, recallID, admissionID string) (*models.RecallAdmissionResource, error)
+}
+
+// subPath returns the path of a sub-resource collection of the transaction under base followed by optional segments.
+func subPath(base, transactionID, collection string, segments ...string) string {
+	return path.Join(append([]string{base, transactionID, collection}, segments...)...)
+}
+
+type returnsClient struct {
+	c *Client
+	// base is the path of the collection of transactions, such as /v1/transaction/payments.
+	base string
+}
+
+func (s *returnsClient) Create(ctx context.Context, transactionID string, attributes *models.ReturnAttributes) (*models.ReturnResource, error) {
+	request := &models.ReturnResource{
+		Resource:   s.c.newResource("returns"),
+		Attributes: attributes,
+	}
+	return createResource[models.ReturnResource](ctx, s.c, subPath(s.base, transactionID, "returns"), request.ID, request)
+}
+
+func (s *returnsClient) Fetch(ctx context.Context, transactionID, returnID string) (*models.ReturnResource, error) {
+	return fetchResource[models.ReturnResource](ctx, s.c, subPath(s.base, transactionID, "returns", returnID))
+}
+
+func (s *returnsClient) CreateSubmission(ctx context.Context, transactionID, returnID string) (*models.ReturnSubmissionResource, error) {
+	request := &models.ReturnSubmissionResource{
+		Resource: s.c.newResource("return_submissions"),
+	}
+	p := subPath(s.base, transactionID, "returns", returnID, "submissions")
+	return createResource[models.ReturnSubmissionResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *returnsClient) FetchSubmission(ctx context.Context, transactionID, returnID, submissionID string) (*models.ReturnSubmissionResource, error) {
+	p := subPath(s.base, transactionID, "returns", returnID, "submissions", submissionID)
+	return fetchResource[models.ReturnSubmissionResource](ctx, s.c, p)
+}
+
+func (s *returnsClient) CreateAdmission(ctx context.Context, transactionID, returnID string) (*models.ReturnAdmissionResource, error) {
+	request := &models.ReturnAdmissionResource{
+		Resource: s.c.newResource("return_admissions"),
+	}
+	p := subPath(s.base, transactionID, "returns", returnID, "admissions")
+	return createResource[models.ReturnAdmissionResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *returnsClient) FetchAdmission(ctx context.Context, transactionID, returnID, admissionID string) (*models.ReturnAdmissionResource, error) {
+	p := subPath(s.base, transactionID, "returns", returnID, "admissions", admissionID)
+	return fetchResource[models.ReturnAdmissionResource](ctx, s.c, p)
+}
+
+type reversalsClient struct {
+	c    *Client
+	base string
+}
+
+func (s *reversalsClient) Create(ctx context.Context, transactionID string, attributes *models.ReversalAttributes) (*models.ReversalResource, error) {
+	request := &models.ReversalResource{
+		Resource:   s.c.newResource("reversals"),
+		Attributes: attributes,
+	}
+	return createResource[models.ReversalResource](ctx, s.c, subPath(s.base, transactionID, "reversals"), request.ID, request)
+}
+
+func (s *reversalsClient) Fetch(ctx context.Context, transactionID, reversalID string) (*models.ReversalResource, error) {
+	return fetchResource[models.ReversalResource](ctx, s.c, subPath(s.base, transactionID, "reversals", reversalID))
+}
+
+func (s *reversalsClient) CreateSubmission(ctx context.Context, transactionID, reversalID string) (*models.ReversalSubmissionResource, error) {
+	request := &models.ReversalSubmissionResource{
+		Resource: s.c.newResource("reversal_submissions"),
+	}
+	p := subPath(s.base, transactionID, "reversals", reversalID, "submissions")
+	return createResource[models.ReversalSubmissionResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *reversalsClient) FetchSubmission(ctx context.Context, transactionID, reversalID, submissionID string) (*models.ReversalSubmissionResource, error) {
+	p := subPath(s.base, transactionID, "reversals", reversalID, "submissions", submissionID)
+	return fetchResource[models.ReversalSubmissionResource](ctx, s.c, p)
+}
+
+func (s *reversalsClient) CreateAdmission(ctx context.Context, transactionID, reversalID string) (*models.ReversalAdmissionResource, error) {
+	request := &models.ReversalAdmissionResource{
+		Resource: s.c.newResource("reversal_admissions"),
+	}
+	p := subPath(s.base, transactionID, "reversals", reversalID, "admissions")
+	return createResource[models.ReversalAdmissionResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *reversalsClient) FetchAdmission(ctx context.Context, transactionID, reversalID, admissionID string) (*models.ReversalAdmissionResource, error) {
+	p := subPath(s.base, transactionID, "reversals", reversalID, "admissions", admissionID)
+	return fetchResource[models.ReversalAdmissionResource](ctx, s.c, p)
+}
+
+type recallsClient struct {
+	c    *Client
+	base string
+}
+
+func (s *recallsClient) Create(ctx context.Context, transactionID string, attributes *models.RecallAttributes) (*models.RecallResource, error) {
+	request := &models.RecallResource{
+		Resource:   s.c.newResource("recalls"),
+		Attributes: attributes,
+	}
+	return createResource[models.RecallResource](ctx, s.c, subPath(s.base, transactionID, "recalls"), request.ID, request)
+}
+
+func (s *recallsClient) Fetch(ctx context.Context, transactionID, recallID string) (*models.RecallResource, error) {
+	return fetchResource[models.RecallResource](ctx, s.c, subPath(s.base, transactionID, "recalls", recallID))
+}
+
+func (s *recallsClient) CreateSubmission(ctx context.Context, transactionID, recallID string) (*models.RecallSubmissionResource, error) {
+	request := &models.RecallSubmissionResource{
+		Resource: s.c.newResource("recall_submissions"),
+	}
+	p := subPath(s.base, transactionID, "recalls", recallID, "submissions")
+	return createResource[models.RecallSubmissionResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *recallsClient) FetchSubmission(ctx context.Context, transactionID, recallID, submissionID string) (*models.RecallSubmissionResource, error) {
+	p := subPath(s.base, transactionID, "recalls", recallID, "submissions", submissionID)
+	return fetchResource[models.RecallSubmissionResource](ctx, s.c, p)
+}
+
+func (s *recallsClient) CreateAdmission(ctx context.Context, transactionID, recallID string) (*models.RecallAdmissionResource, error) {
+	request := &models.RecallAdmissionResource{
+		Resource: s.c.newResource("recall_admissions"),
+	}
+	p := subPath(s.base, transactionID, "recalls", recallID, "admissions")
+	return createResource[models.RecallAdmissionResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *recallsClient) FetchAdmission(ctx context.Context, transactionID, recallID, admissionID string) (*models.RecallAdmissionResource, error) {
+	p := subPath(s.base, transactionID, "recalls", recallID, "admissions", admissionID)
+	return fetchResource[models.RecallAdmissionResource](ctx, s.c, p)
+}
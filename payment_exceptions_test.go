@@ -0,0 +1,182 @@
+package form3 // Intentionally do not use `form3_test` to mock Api.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3/models"
+)
+
+func Test_paymentExceptionClients(t *testing.T) {
+	runCallTests(t, []callTest{
+		{
+			name: "create return",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Returns().Create(ctx, "p1", &models.ReturnAttributes{ReturnCode: models.ReturnCodeClosedAccountNumber})
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/payments/p1/returns",
+			resourceType: "returns",
+		},
+		{
+			name: "fetch return",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Returns().Fetch(ctx, "p1", "r1")
+				return err
+			},
+			method: "GET",
+			path:   "/v1/transaction/payments/p1/returns/r1",
+		},
+		{
+			name: "create return submission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Returns().CreateSubmission(ctx, "p1", "r1")
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/payments/p1/returns/r1/submissions",
+			resourceType: "return_submissions",
+		},
+		{
+			name: "fetch return admission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Returns().FetchAdmission(ctx, "p1", "r1", "a1")
+				return err
+			},
+			method: "GET",
+			path:   "/v1/transaction/payments/p1/returns/r1/admissions/a1",
+		},
+		{
+			name: "create reversal",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Reversals().Create(ctx, "p1", &models.ReversalAttributes{})
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/payments/p1/reversals",
+			resourceType: "reversals",
+		},
+		{
+			name: "create reversal admission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Reversals().CreateAdmission(ctx, "p1", "r1")
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/payments/p1/reversals/r1/admissions",
+			resourceType: "reversal_admissions",
+		},
+		{
+			name: "fetch reversal submission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Reversals().FetchSubmission(ctx, "p1", "r1", "s1")
+				return err
+			},
+			method: "GET",
+			path:   "/v1/transaction/payments/p1/reversals/r1/submissions/s1",
+		},
+		{
+			name: "create recall",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Recalls().Create(ctx, "p1", &models.RecallAttributes{ReasonCode: models.RecallReasonCodeDuplicatePayment})
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/payments/p1/recalls",
+			resourceType: "recalls",
+		},
+		{
+			name: "create recall submission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Recalls().CreateSubmission(ctx, "p1", "r1")
+				return err
+			},
+			method:       "POST",
+			path:         "/v1/transaction/payments/p1/recalls/r1/submissions",
+			resourceType: "recall_submissions",
+		},
+		{
+			name: "fetch recall admission",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.Payments().Recalls().FetchAdmission(ctx, "p1", "r1", "a1")
+				return err
+			},
+			method: "GET",
+			path:   "/v1/transaction/payments/p1/recalls/r1/admissions/a1",
+		},
+	})
+}
+
+// callTest is a test case of a client method performing a single API call.
+type callTest struct {
+	name   string
+	call   func(ctx context.Context, c *Client) error
+	method string
+	path   string
+	// resourceType is the type of the resource sent in the request body. Empty for calls without a body.
+	resourceType string
+	// request optionally checks the request body.
+	request func(t *testing.T, request any)
+}
+
+// runCallTests runs every test case against a mocked Api, checking the method, the path and the new resource sent by the call.
+func runCallTests(t *testing.T, tests []callTest) {
+	const id = "f2037281-8242-43e6-8536-0614f0b65253"
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiMock := &ApiMock{
+				DoFunc: func(ctx context.Context, call *Call) error {
+					assert.Equal(t, tt.method, call.Method)
+					assert.Equal(t, tt.path, call.Path)
+					assert.NotNil(t, call.Response)
+					if tt.resourceType == "" {
+						assert.Nil(t, call.Request)
+						return nil
+					}
+
+					// Every request resource embeds models.Resource, so its JSON has the ID and the type at the top level.
+					data, err := json.Marshal(call.Request)
+					require.NoError(t, err)
+					var resource models.Resource
+					require.NoError(t, json.Unmarshal(data, &resource))
+					assert.Equal(t, id, resource.ID)
+					assert.Equal(t, tt.resourceType, resource.Type)
+					if tt.request != nil {
+						tt.request(t, call.Request)
+					}
+					return nil
+				},
+			}
+			client := New().SetUuidProvider(func() string { return id })
+			client.api = apiMock
+
+			require.NoError(t, tt.call(context.Background(), client))
+			require.Equal(t, 1, len(apiMock.calls.Do))
+		})
+	}
+}
+
+func Test_returnsClient_Create_IdempotentConflict(t *testing.T) {
+	apiMock := &ApiMock{
+		DoFunc: func(ctx context.Context, call *Call) error {
+			if call.Method == "POST" {
+				return Error{StatusCode: http.StatusConflict}
+			}
+			assert.Equal(t, "/v1/transaction/payments/p1/returns/f2037281-8242-43e6-8536-0614f0b65253", call.Path)
+			return nil
+		},
+	}
+	client := New().SetUuidProvider(func() string { return "f2037281-8242-43e6-8536-0614f0b65253" })
+	client.api = apiMock
+
+	_, err := client.Payments().Returns().Create(context.Background(), "p1", &models.ReturnAttributes{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(apiMock.calls.Do))
+	assert.Equal(t, "GET", apiMock.calls.Do[1].Call.Method)
+}
@@ -0,0 +1,132 @@
+package form3
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// PaymentsClient is the Form3 API client for /v1/transaction/payments endpoints.
+type PaymentsClient interface {
+	// Create a new payment. The payment is not sent to the scheme until a submission is created.
+	Create(ctx context.Context, attributes *models.PaymentAttributes) (*models.PaymentResource, error)
+	// Fetch a single Payment resource using the resource ID.
+	Fetch(ctx context.Context, id string) (*models.PaymentResource, error)
+	// List a single page of Payment resources matching an optional filter.
+	List(ctx context.Context, filter *PaymentFilter, opts *ListOptions) (*Page[*models.PaymentResource], error)
+	// Iter returns an Iterator over all Payment resources matching an optional filter, starting from the page given in opts. Pages are fetched lazily.
+	Iter(ctx context.Context, filter *PaymentFilter, opts *ListOptions) *Iterator[*models.PaymentResource]
+	// CreateSubmission submits the payment to the payment scheme.
+	CreateSubmission(ctx context.Context, paymentID string) (*models.PaymentSubmissionResource, error)
+	// FetchSubmission fetches a single Payment Submission resource using the payment ID and the submission ID.
+	FetchSubmission(ctx context.Context, paymentID, submissionID string) (*models.PaymentSubmissionResource, error)
+	// Returns returns ReturnsClient to access returns of payments.
+	Returns() ReturnsClient
+	// Reversals returns ReversalsClient to access reversals of payments.
+	Reversals() ReversalsClient
+	// Recalls returns RecallsClient to access recalls of payments.
+	Recalls() RecallsClient
+}
+
+// PaymentFilter restricts the Payment resources returned by list endpoints. Empty fields are ignored.
+type PaymentFilter struct {
+	Currency      string
+	PaymentScheme string
+	// ProcessingDateFrom is the earliest processing date in the YYYY-MM-DD format.
+	ProcessingDateFrom string
+	// ProcessingDateTo is the latest processing date in the YYYY-MM-DD format.
+	ProcessingDateTo string
+}
+
+func (f *PaymentFilter) queryParams() (url.Values, error) {
+	if f == nil {
+		return url.Values{}, nil
+	}
+	if err := validateProcessingDates("payment", f.ProcessingDateFrom, f.ProcessingDateTo); err != nil {
+		return nil, err
+	}
+
+	return filterParams(map[string]string{
+		"currency":             f.Currency,
+		"payment_scheme":       f.PaymentScheme,
+		"processing_date_from": f.ProcessingDateFrom,
+		"processing_date_to":   f.ProcessingDateTo,
+	}), nil
+}
+
+const paymentsPath = "/v1/transaction/payments"
+
+type paymentsClient struct {
+	c         *Client
+	returns   ReturnsClient
+	reversals ReversalsClient
+	recalls   RecallsClient
+}
+
+func newPaymentsClient(c *Client) *paymentsClient {
+	return &paymentsClient{
+		c:         c,
+		returns:   &returnsClient{c: c, base: paymentsPath},
+		reversals: &reversalsClient{c: c, base: paymentsPath},
+		recalls:   &recallsClient{c: c, base: paymentsPath},
+	}
+}
+
+func (s *paymentsClient) Create(ctx context.Context, attributes *models.PaymentAttributes) (*models.PaymentResource, error) {
+	request := &models.PaymentResource{
+		Resource:   s.c.newResource("payments"),
+		Attributes: attributes,
+	}
+	return createResource[models.PaymentResource](ctx, s.c, paymentsPath, request.ID, request)
+}
+
+func (s *paymentsClient) Fetch(ctx context.Context, id string) (*models.PaymentResource, error) {
+	return fetchResource[models.PaymentResource](ctx, s.c, fmt.Sprintf("%s/%s", paymentsPath, id))
+}
+
+func (s *paymentsClient) List(ctx context.Context, filter *PaymentFilter, opts *ListOptions) (*Page[*models.PaymentResource], error) {
+	params, err := listParams(filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.list(ctx, params)
+}
+
+func (s *paymentsClient) Iter(ctx context.Context, filter *PaymentFilter, opts *ListOptions) *Iterator[*models.PaymentResource] {
+	params, err := listParams(filter, opts)
+	if err != nil {
+		return newFailedIterator[*models.PaymentResource](err)
+	}
+	return newIterator(ctx, params, s.list)
+}
+
+func (s *paymentsClient) list(ctx context.Context, params url.Values) (*Page[*models.PaymentResource], error) {
+	return listPage[*models.PaymentResource](ctx, s.c, paymentsPath, params)
+}
+
+func (s *paymentsClient) CreateSubmission(ctx context.Context, paymentID string) (*models.PaymentSubmissionResource, error) {
+	request := &models.PaymentSubmissionResource{
+		Resource: s.c.newResource("payment_submissions"),
+	}
+	p := subPath(paymentsPath, paymentID, "submissions")
+	return createResource[models.PaymentSubmissionResource](ctx, s.c, p, request.ID, request)
+}
+
+func (s *paymentsClient) FetchSubmission(ctx context.Context, paymentID, submissionID string) (*models.PaymentSubmissionResource, error) {
+	p := subPath(paymentsPath, paymentID, "submissions", submissionID)
+	return fetchResource[models.PaymentSubmissionResource](ctx, s.c, p)
+}
+
+func (s *paymentsClient) Returns() ReturnsClient {
+	return s.returns
+}
+
+func (s *paymentsClient) Reversals() ReversalsClient {
+	return s.reversals
+}
+
+func (s *paymentsClient) Recalls() RecallsClient {
+	return s.recalls
+}
@@ -0,0 +1,208 @@
+package form3 // Intentionally do not use `form3_test` to mock Api.
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3/models"
+)
+
+func Test_paymentsClient_Create(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				assert.Equal(t, "POST", call.Method)
+				assert.Equal(t, "/v1/transaction/payments", call.Path)
+				assert.IsType(t, &models.PaymentResource{}, call.Response)
+				require.IsType(t, &models.PaymentResource{}, call.Request)
+
+				req := call.Request.(*models.PaymentResource)
+				assert.Equal(t, "payments", req.Type)
+				assert.Equal(t, "c52fb94b-a795-4c77-969a-74e2364edb28", req.OrganisationId)
+				assert.Equal(t, "f2037281-8242-43e6-8536-0614f0b65253", req.ID)
+				assert.Equal(t, "100.21", req.Attributes.Amount)
+				assert.Equal(t, models.CurrencyGBP, req.Attributes.Currency)
+				assert.Equal(t, "41426819", req.Attributes.BeneficiaryParty.AccountNumber)
+				return nil
+			},
+		}
+		client := New().
+			SetUuidProvider(func() string { return "f2037281-8242-43e6-8536-0614f0b65253" }).
+			SetOrganisationId("c52fb94b-a795-4c77-969a-74e2364edb28")
+		client.api = apiMock
+
+		_, err := client.Payments().Create(context.Background(), &models.PaymentAttributes{
+			Amount:   "100.21",
+			Currency: models.CurrencyGBP,
+			BeneficiaryParty: &models.PaymentParty{
+				AccountNumber: "41426819",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(apiMock.calls.Do))
+	})
+
+	t.Run("idempotent conflict", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				switch call.Method {
+				case "POST":
+					return Error{StatusCode: http.StatusConflict}
+				case "GET":
+					assert.Equal(t, "/v1/transaction/payments/f2037281-8242-43e6-8536-0614f0b65253", call.Path)
+				}
+				return nil
+			},
+		}
+		client := New().SetUuidProvider(func() string { return "f2037281-8242-43e6-8536-0614f0b65253" })
+		client.api = apiMock
+
+		_, err := client.Payments().Create(context.Background(), &models.PaymentAttributes{})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(apiMock.calls.Do))
+		assert.Equal(t, "GET", apiMock.calls.Do[1].Call.Method)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				return Error{StatusCode: http.StatusInternalServerError}
+			},
+		}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Payments().Create(context.Background(), &models.PaymentAttributes{})
+		require.ErrorAs(t, err, &Error{})
+		assert.Equal(t, ErrorServerError, err.(Error).Type())
+	})
+}
+
+func Test_paymentsClient_Fetch(t *testing.T) {
+	apiMock := &ApiMock{
+		DoFunc: func(ctx context.Context, call *Call) error {
+			assert.Equal(t, "GET", call.Method)
+			assert.Equal(t, "/v1/transaction/payments/123", call.Path)
+			assert.Nil(t, call.Request)
+			assert.IsType(t, &models.PaymentResource{}, call.Response)
+			return nil
+		},
+	}
+	client := New()
+	client.api = apiMock
+
+	_, err := client.Payments().Fetch(context.Background(), "123")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(apiMock.calls.Do))
+}
+
+func Test_paymentsClient_List(t *testing.T) {
+	t.Run("filter", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				assert.Equal(t, "GET", call.Method)
+				assert.Equal(t, "/v1/transaction/payments", call.Path)
+				assert.Equal(t, url.Values{
+					"filter[currency]":             {"GBP"},
+					"filter[processing_date_from]": {"2022-11-01"},
+					"filter[processing_date_to]":   {"2022-11-30"},
+					"page[number]":                 {"1"},
+				}, call.QueryParams)
+				assert.IsType(t, &[]*models.PaymentResource{}, call.Response)
+				return nil
+			},
+		}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Payments().List(context.Background(), &PaymentFilter{
+			Currency:           models.CurrencyGBP,
+			ProcessingDateFrom: "2022-11-01",
+			ProcessingDateTo:   "2022-11-30",
+		}, &ListOptions{PageNumber: 1})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(apiMock.calls.Do))
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		apiMock := &ApiMock{}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Payments().List(context.Background(), &PaymentFilter{ProcessingDateFrom: "01/11/2022"}, nil)
+		assert.ErrorIs(t, err, ErrValidation)
+		assert.ErrorContains(t, err, "filter[processing_date_from]")
+
+		it := client.Payments().Iter(context.Background(), &PaymentFilter{
+			ProcessingDateFrom: "2022-11-30",
+			ProcessingDateTo:   "2022-11-01",
+		}, nil)
+		assert.False(t, it.Next())
+		assert.ErrorContains(t, it.Err(), "is after filter[processing_date_to]")
+
+		assert.Equal(t, 0, len(apiMock.calls.Do))
+	})
+}
+
+func Test_paymentsClient_CreateSubmission(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				assert.Equal(t, "POST", call.Method)
+				assert.Equal(t, "/v1/transaction/payments/123/submissions", call.Path)
+				assert.IsType(t, &models.PaymentSubmissionResource{}, call.Response)
+				require.IsType(t, &models.PaymentSubmissionResource{}, call.Request)
+
+				req := call.Request.(*models.PaymentSubmissionResource)
+				assert.Equal(t, "payment_submissions", req.Type)
+				assert.Equal(t, "f2037281-8242-43e6-8536-0614f0b65253", req.ID)
+				return nil
+			},
+		}
+		client := New().SetUuidProvider(func() string { return "f2037281-8242-43e6-8536-0614f0b65253" })
+		client.api = apiMock
+
+		_, err := client.Payments().CreateSubmission(context.Background(), "123")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(apiMock.calls.Do))
+	})
+
+	t.Run("idempotent conflict", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				if call.Method == "POST" {
+					return Error{StatusCode: http.StatusConflict}
+				}
+				assert.Equal(t, "/v1/transaction/payments/123/submissions/f2037281-8242-43e6-8536-0614f0b65253", call.Path)
+				return nil
+			},
+		}
+		client := New().SetUuidProvider(func() string { return "f2037281-8242-43e6-8536-0614f0b65253" })
+		client.api = apiMock
+
+		_, err := client.Payments().CreateSubmission(context.Background(), "123")
+		require.NoError(t, err)
+		require.Equal(t, 2, len(apiMock.calls.Do))
+	})
+}
+
+func Test_paymentsClient_FetchSubmission(t *testing.T) {
+	apiMock := &ApiMock{
+		DoFunc: func(ctx context.Context, call *Call) error {
+			assert.Equal(t, "GET", call.Method)
+			assert.Equal(t, "/v1/transaction/payments/123/submissions/456", call.Path)
+			assert.IsType(t, &models.PaymentSubmissionResource{}, call.Response)
+			return nil
+		},
+	}
+	client := New()
+	client.api = apiMock
+
+	_, err := client.Payments().FetchSubmission(context.Background(), "123", "456")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(apiMock.calls.Do))
+}
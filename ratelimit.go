@@ -0,0 +1,68 @@
+package form3
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers reporting the rate limit status of the API.
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	RateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+// RateLimit is the rate limit status reported by the API in X-RateLimit-* headers.
+type RateLimit struct {
+	// Limit is the maximum number of requests allowed in the current window.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is the time when the current window resets.
+	Reset time.Time
+}
+
+// parseRateLimit returns the rate limit status from the response headers or nil if they are missing or malformed.
+// X-RateLimit-Reset is expected to be a Unix timestamp in seconds.
+func parseRateLimit(header http.Header) *RateLimit {
+	limit, errL := strconv.Atoi(header.Get(RateLimitLimitHeader))
+	remaining, errR := strconv.Atoi(header.Get(RateLimitRemainingHeader))
+	if errL != nil || errR != nil {
+		return nil
+	}
+
+	rl := &RateLimit{Limit: limit, Remaining: remaining}
+	if reset, err := strconv.ParseInt(header.Get(RateLimitResetHeader), 10, 64); err == nil {
+		rl.Reset = time.Unix(reset, 0)
+	}
+	return rl
+}
+
+// parseRetryAfter returns the delay requested by the Retry-After header, which is either a number of seconds or an HTTP date.
+// Returns zero if the header is missing or malformed.
+func parseRetryAfter(header http.Header, now time.Time) time.Duration {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// retryAfter returns the delay the API requested before the next request: either explicitly with Retry-After or implicitly
+// with an exhausted rate limit that resets in the future.
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	delay := parseRetryAfter(header, now)
+	if rl := parseRateLimit(header); rl != nil && rl.Remaining == 0 && rl.Reset.After(now) {
+		if untilReset := rl.Reset.Sub(now); untilReset > delay {
+			delay = untilReset
+		}
+	}
+	return delay
+}
@@ -0,0 +1,124 @@
+package form3
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseRateLimit(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		want   *RateLimit
+	}{
+		{
+			name:   "missing",
+			header: http.Header{},
+			want:   nil,
+		},
+		{
+			name: "malformed",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"many"},
+				"X-Ratelimit-Remaining": {"10"},
+			},
+			want: nil,
+		},
+		{
+			name: "without reset",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"100"},
+				"X-Ratelimit-Remaining": {"10"},
+			},
+			want: &RateLimit{Limit: 100, Remaining: 10},
+		},
+		{
+			name: "with reset",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"100"},
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {"1668000000"},
+			},
+			want: &RateLimit{Limit: 100, Remaining: 0, Reset: time.Unix(1668000000, 0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRateLimit(tt.header))
+		})
+	}
+}
+
+func Test_retryAfter(t *testing.T) {
+	now := time.Date(2022, 11, 9, 12, 0, 0, 0, time.UTC)
+	reset := strconv.FormatInt(now.Add(90*time.Second).Unix(), 10)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+	}{
+		{
+			name:   "missing",
+			header: http.Header{},
+			want:   0,
+		},
+		{
+			name:   "seconds",
+			header: http.Header{"Retry-After": {"30"}},
+			want:   30 * time.Second,
+		},
+		{
+			name:   "http date",
+			header: http.Header{"Retry-After": {now.Add(time.Minute).Format(http.TimeFormat)}},
+			want:   time.Minute,
+		},
+		{
+			name:   "http date in the past",
+			header: http.Header{"Retry-After": {now.Add(-time.Minute).Format(http.TimeFormat)}},
+			want:   0,
+		},
+		{
+			name:   "malformed",
+			header: http.Header{"Retry-After": {"soon"}},
+			want:   0,
+		},
+		{
+			name: "exhausted rate limit",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"100"},
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {reset},
+			},
+			want: 90 * time.Second,
+		},
+		{
+			name: "remaining rate limit",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"100"},
+				"X-Ratelimit-Remaining": {"1"},
+				"X-Ratelimit-Reset":     {reset},
+			},
+			want: 0,
+		},
+		{
+			name: "longest of both",
+			header: http.Header{
+				"Retry-After":           {"30"},
+				"X-Ratelimit-Limit":     {"100"},
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {reset},
+			},
+			want: 90 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, retryAfter(tt.header, now))
+		})
+	}
+}
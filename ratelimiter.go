@@ -0,0 +1,161 @@
+package form3
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a client-side token-bucket rate limiter shared by all API calls of a Client.
+// Every attempt waits for a token from the bucket matching the call, so that the organisation's quota is not exceeded.
+// Buckets adapt to X-RateLimit-* headers returned by the API: an exhausted rate limit blocks the bucket until the reset time,
+// and the rate is lowered if the remaining quota would otherwise run out before the reset.
+//
+// A RateLimiter is safe for concurrent use.
+type RateLimiter struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	def     *bucket
+	buckets []*bucket
+}
+
+type bucket struct {
+	method     string
+	pathPrefix string
+	rate       float64
+	burst      float64
+
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+	adaptedRate  float64
+	adaptedUntil time.Time
+}
+
+// NewRateLimiter creates a new RateLimiter with the default bucket allowing `rate` requests per second with bursts of up to `burst` requests.
+// It panics if rate or burst is not positive.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		now: time.Now,
+		def: newBucket("", "", rate, burst),
+	}
+}
+
+// AddBucket adds a separate bucket for calls with the given HTTP method and path prefix. An empty method matches all methods.
+// Buckets are matched in the order they were added; calls matching no bucket use the default one.
+// It panics if rate or burst is not positive.
+func (l *RateLimiter) AddBucket(method, pathPrefix string, rate float64, burst int) *RateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.buckets = append(l.buckets, newBucket(method, pathPrefix, rate, burst))
+	return l
+}
+
+func newBucket(method, pathPrefix string, rate float64, burst int) *bucket {
+	// A zero rate would make delays infinite, which do not convert to time.Duration.
+	if !(rate > 0) || math.IsInf(rate, 1) || burst <= 0 {
+		panic(fmt.Sprintf("form3: invalid rate limiter bucket: rate %v, burst %d", rate, burst))
+	}
+	return &bucket{
+		method:     method,
+		pathPrefix: pathPrefix,
+		rate:       rate,
+		burst:      float64(burst),
+		tokens:     float64(burst),
+	}
+}
+
+func (l *RateLimiter) bucket(call *Call) *bucket {
+	for _, b := range l.buckets {
+		if (b.method == "" || b.method == call.Method) && strings.HasPrefix(call.Path, b.pathPrefix) {
+			return b
+		}
+	}
+	return l.def
+}
+
+// wait blocks until the bucket of the call has a token or the context is done.
+func (l *RateLimiter) wait(ctx context.Context, call *Call) error {
+	l.mu.Lock()
+	b := l.bucket(call)
+	delay := b.reserve(l.now())
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		b.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// observe adapts the bucket of the call to the rate limit status reported by the API.
+func (l *RateLimiter) observe(call *Call, header http.Header) {
+	rl := parseRateLimit(header)
+	if rl == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b := l.bucket(call)
+	b.refill(now)
+
+	if rl.Remaining <= 0 {
+		if rl.Reset.After(now) {
+			b.blockedUntil = rl.Reset
+		}
+		return
+	}
+	if rl.Reset.After(now) {
+		b.adaptedRate = float64(rl.Remaining) / rl.Reset.Sub(now).Seconds()
+		b.adaptedUntil = rl.Reset
+	}
+	b.tokens = math.Min(b.tokens, float64(rl.Remaining))
+}
+
+// currentRate returns the configured rate, lowered to the adapted one until the API rate limit resets.
+func (b *bucket) currentRate(now time.Time) float64 {
+	if now.Before(b.adaptedUntil) {
+		return math.Min(b.rate, b.adaptedRate)
+	}
+	return b.rate
+}
+
+func (b *bucket) refill(now time.Time) {
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.currentRate(now))
+	}
+	b.last = now
+}
+
+// reserve takes a token from the bucket and returns how long to wait before using it.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.refill(now)
+	b.tokens--
+
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.currentRate(now) * float64(time.Second))
+	}
+	if blocked := b.blockedUntil.Sub(now); blocked > delay {
+		delay = blocked
+	}
+	return delay
+}
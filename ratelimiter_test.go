@@ -0,0 +1,171 @@
+package form3
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2022, 11, 9, 12, 0, 0, 0, time.UTC)
+	get := &Call{Method: http.MethodGet, Path: "/v1/organisation/accounts"}
+	post := &Call{Method: http.MethodPost, Path: "/v1/transaction/payments"}
+
+	newLimiter := func() *RateLimiter {
+		l := NewRateLimiter(10, 2)
+		l.now = func() time.Time { return now }
+		return l
+	}
+
+	t.Run("bursts then waits for refill", func(t *testing.T) {
+		l := newLimiter()
+		b := l.bucket(get)
+		assert.Zero(t, b.reserve(now))
+		assert.Zero(t, b.reserve(now))
+		assert.Equal(t, 100*time.Millisecond, b.reserve(now))
+		assert.Equal(t, 200*time.Millisecond, b.reserve(now))
+		assert.Equal(t, 100*time.Millisecond, b.reserve(now.Add(200*time.Millisecond)))
+	})
+
+	t.Run("separate buckets", func(t *testing.T) {
+		l := newLimiter().AddBucket(http.MethodPost, "/v1/transaction", 1, 1)
+		assert.NotSame(t, l.bucket(get), l.bucket(post))
+		assert.Same(t, l.def, l.bucket(&Call{Method: http.MethodGet, Path: "/v1/transaction/payments"}))
+
+		b := l.bucket(post)
+		assert.Zero(t, b.reserve(now))
+		assert.Equal(t, time.Second, b.reserve(now))
+		assert.Zero(t, l.bucket(get).reserve(now))
+	})
+
+	t.Run("blocks until reset when exhausted", func(t *testing.T) {
+		l := newLimiter()
+		l.observe(get, rateLimitHeader(100, 0, now.Add(5*time.Second)))
+		assert.Equal(t, 5*time.Second, l.bucket(get).reserve(now))
+		assert.Zero(t, l.bucket(get).reserve(now.Add(5*time.Second)))
+	})
+
+	t.Run("adapts rate to remaining quota", func(t *testing.T) {
+		l := newLimiter()
+		l.observe(get, rateLimitHeader(100, 1, now.Add(2*time.Second)))
+		b := l.bucket(get)
+		assert.Zero(t, b.reserve(now))
+		assert.Equal(t, 2*time.Second, b.reserve(now))
+		assert.Equal(t, 10.0, b.currentRate(now.Add(2*time.Second)))
+	})
+
+	t.Run("ignores responses without headers", func(t *testing.T) {
+		l := newLimiter()
+		l.observe(get, http.Header{})
+		assert.Equal(t, 2.0, l.bucket(get).tokens)
+	})
+
+	t.Run("wait respects context", func(t *testing.T) {
+		l := newLimiter()
+		l.observe(get, rateLimitHeader(100, 0, now.Add(time.Hour)))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, l.wait(ctx, get), context.DeadlineExceeded)
+		assert.Equal(t, 2.0, l.bucket(get).tokens)
+	})
+}
+
+func TestRateLimiter_Concurrent(t *testing.T) {
+	l := NewRateLimiter(100, 1)
+	call := &Call{Method: http.MethodGet, Path: "/v1/organisation/accounts"}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, l.wait(context.Background(), call))
+		}()
+	}
+	wg.Wait()
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+}
+
+func rateLimitHeader(limit, remaining int, reset time.Time) http.Header {
+	h := http.Header{}
+	h.Set(RateLimitLimitHeader, strconv.Itoa(limit))
+	h.Set(RateLimitRemainingHeader, strconv.Itoa(remaining))
+	h.Set(RateLimitResetHeader, strconv.FormatInt(reset.Unix(), 10))
+	return h
+}
+
+func TestRateLimiter_InvalidBucket(t *testing.T) {
+	assert.Panics(t, func() { NewRateLimiter(0, 1) })
+	assert.Panics(t, func() { NewRateLimiter(-1, 1) })
+	assert.Panics(t, func() { NewRateLimiter(math.NaN(), 1) })
+	assert.Panics(t, func() { NewRateLimiter(10, 0) })
+	assert.Panics(t, func() { NewRateLimiter(10, 1).AddBucket("", "/v1", 0, 1) })
+}
+
+func TestRateLimiter_ReleasesCircuitProbe(t *testing.T) {
+	var healthy int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	cb := NewCircuitBreaker().SetMinAttempts(1).SetFailureRatio(1).SetOpenTimeout(20 * time.Millisecond)
+	client := New().
+		SetBaseUrl(srv.URL).
+		SetCircuitBreaker(cb).
+		SetRateLimiter(NewRateLimiter(5, 1)).
+		SetBackOffProvider(func() BackOff { return &backoff.StopBackOff{} })
+	call := func(ctx context.Context) error {
+		return client.Api().Do(ctx, &Call{Method: http.MethodGet, Path: "/v1/resource"})
+	}
+
+	require.Error(t, call(context.Background()))
+	require.Equal(t, CircuitOpen, cb.State())
+
+	// The circuit is half-open, but the bucket has no token for the probe before the context expires.
+	time.Sleep(30 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, call(ctx), context.DeadlineExceeded)
+	assert.Equal(t, CircuitHalfOpen, cb.State())
+
+	atomic.StoreInt32(&healthy, 1)
+	require.NoError(t, call(context.Background()))
+	assert.Equal(t, CircuitClosed, cb.State())
+}
+
+func TestRateLimiter_InterruptedRetryReportsLastError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	// The first attempt takes the only token, so the retry waits for the next one, which comes after the context expires.
+	client := New().
+		SetBaseUrl(srv.URL).
+		SetRateLimiter(NewRateLimiter(1, 1)).
+		SetBackOffProvider(func() BackOff { return &backoff.ZeroBackOff{} })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := client.Api().Do(ctx, &Call{Method: http.MethodGet, Path: "/v1/resource"})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	var apiErr Error
+	require.ErrorAs(t, err, &apiErr, "the error of the last attempt is reported")
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+}
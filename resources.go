@@ -0,0 +1,98 @@
+package form3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// newResource returns the envelope of a new resource with a client-generated ID.
+func (c *Client) newResource(resourceType string) models.Resource {
+	return models.Resource{
+		ID:             c.uuidProvider(),
+		OrganisationId: c.organisationId,
+		Type:           resourceType,
+	}
+}
+
+// versionedResource returns the envelope of an existing resource to modify its given version.
+func (c *Client) versionedResource(resourceType, id string, version int) models.Resource {
+	return models.Resource{
+		ID:             id,
+		OrganisationId: c.organisationId,
+		Type:           resourceType,
+		Version:        &version,
+	}
+}
+
+// createResource creates a new resource under the given collection path.
+// The call is idempotent thanks to the client-generated ID: if a resource with the same ID already exists
+// (e.g. the previous attempt succeeded, but the response was lost), it is fetched instead.
+func createResource[T any](ctx context.Context, c *Client, path, id string, request any) (*T, error) {
+	response := new(T)
+	call := &Call{
+		Method:     "POST",
+		Path:       path,
+		Request:    request,
+		Response:   response,
+		Idempotent: true,
+	}
+	err := c.Api().Do(ctx, call)
+
+	if errors.Is(err, ErrConflict) {
+		return fetchResource[T](ctx, c, fmt.Sprintf("%s/%s", path, id))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// fetchResource fetches a single resource by its path.
+func fetchResource[T any](ctx context.Context, c *Client, path string) (*T, error) {
+	response := new(T)
+	call := &Call{
+		Method:   "GET",
+		Path:     path,
+		Response: response,
+	}
+	if err := c.Api().Do(ctx, call); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// updateResource patches the given version of the resource at the path. Returns VersionConflictError if the version is not the current one.
+func updateResource[T any](ctx context.Context, c *Client, path, id string, version int, request any) (*T, error) {
+	response := new(T)
+	call := &Call{
+		Method:   "PATCH",
+		Path:     path,
+		Request:  request,
+		Response: response,
+	}
+	err := c.Api().Do(ctx, call)
+
+	if errors.Is(err, ErrConflict) {
+		return nil, VersionConflictError{ID: id, Version: version, Err: err}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// deleteResource deletes the given version of the resource at the path.
+func deleteResource(ctx context.Context, c *Client, path string, version int) error {
+	call := &Call{
+		Method:      "DELETE",
+		Path:        path,
+		QueryParams: url.Values{},
+	}
+	call.QueryParams.Add("version", strconv.Itoa(version))
+	return c.Api().Do(ctx, call)
+}
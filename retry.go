@@ -0,0 +1,67 @@
+package form3
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// RetryPolicy decides whether a failed attempt to perform an API call should be retried.
+// Delays between attempts are governed separately by BackOff.
+type RetryPolicy interface {
+	// ShouldRetry reports whether the call should be retried after the attempt with the given zero-based index failed with err.
+	// The response is nil if no response was received; its body must not be read.
+	ShouldRetry(call *Call, attempt int, resp *http.Response, err error) bool
+}
+
+// RetryPolicyFunc is an adapter to allow the use of ordinary functions as RetryPolicy.
+type RetryPolicyFunc func(call *Call, attempt int, resp *http.Response, err error) bool
+
+// ShouldRetry calls f(call, attempt, resp, err).
+func (f RetryPolicyFunc) ShouldRetry(call *Call, attempt int, resp *http.Response, err error) bool {
+	return f(call, attempt, resp, err)
+}
+
+// DefaultRetryPolicy is the RetryPolicy used in form3.Client by default. It retries idempotent calls that failed with
+// HTTP 429, HTTP 5xx, a network error or ErrAttemptTimeout. Cancelled contexts, TLS and certificate failures are not retried.
+var DefaultRetryPolicy RetryPolicy = RetryPolicyFunc(defaultShouldRetry)
+
+func defaultShouldRetry(call *Call, _ int, _ *http.Response, err error) bool {
+	if !call.idempotent() {
+		return false
+	}
+
+	var apiErr Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Type() == ErrorServerError || apiErr.Type() == ErrorTooManyRequests
+	}
+
+	switch {
+	case errors.Is(err, ErrAttemptTimeout):
+		return true
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	case isTLSError(err):
+		return false
+	}
+
+	// Errors returned by http.Client are *url.Error, which implements net.Error.
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func isTLSError(err error) bool {
+	var (
+		unknownAuthority   x509.UnknownAuthorityError
+		certificateInvalid x509.CertificateInvalidError
+		hostname           x509.HostnameError
+		recordHeader       tls.RecordHeaderError
+	)
+	return errors.As(err, &unknownAuthority) ||
+		errors.As(err, &certificateInvalid) ||
+		errors.As(err, &hostname) ||
+		errors.As(err, &recordHeader)
+}
@@ -0,0 +1,76 @@
+package form3_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"mkuznets.com/go/form3"
+)
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	get := &form3.Call{Method: "GET", Path: "/v1/resource"}
+	post := &form3.Call{Method: "POST", Path: "/v1/resource"}
+	idempotentPost := &form3.Call{Method: "POST", Path: "/v1/resource", Idempotent: true}
+	urlErr := func(err error) error {
+		return &url.Error{Op: "Get", URL: "https://api.form3.tech/v1/resource", Err: err}
+	}
+
+	tests := []struct {
+		name string
+		call *form3.Call
+		err  error
+		want bool
+	}{
+		{name: "server error", call: get, err: form3.Error{StatusCode: 503}, want: true},
+		{name: "too many requests", call: get, err: form3.Error{StatusCode: 429}, want: true},
+		{name: "client error", call: get, err: form3.Error{StatusCode: 400}, want: false},
+		{name: "conflict", call: get, err: form3.Error{StatusCode: 409}, want: false},
+		{name: "connection error", call: get, err: urlErr(io.EOF), want: true},
+		{name: "attempt timeout", call: get, err: fmt.Errorf("%w: %v", form3.ErrAttemptTimeout, urlErr(context.DeadlineExceeded)), want: true},
+		{name: "context cancelled", call: get, err: urlErr(context.Canceled), want: false},
+		{name: "context deadline", call: get, err: urlErr(context.DeadlineExceeded), want: false},
+		{name: "unknown certificate authority", call: get, err: urlErr(x509.UnknownAuthorityError{}), want: false},
+		{name: "invalid hostname", call: get, err: urlErr(x509.HostnameError{Host: "example.com"}), want: false},
+		{name: "json error", call: get, err: &json.SyntaxError{}, want: false},
+		{name: "other error", call: get, err: errors.New("signing failed"), want: false},
+		{name: "non-idempotent call", call: post, err: form3.Error{StatusCode: 503}, want: false},
+		{name: "idempotent POST", call: idempotentPost, err: form3.Error{StatusCode: 503}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, form3.DefaultRetryPolicy.ShouldRetry(tt.call, 0, nil, tt.err))
+		})
+	}
+}
+
+func TestClient_SetRetryPolicy(t *testing.T) {
+	handlerMock := failingHandlerMock(5, http.StatusBadRequest)
+	ts := httptest.NewServer(handlerMock)
+	defer ts.Close()
+
+	var statuses []int
+	policy := form3.RetryPolicyFunc(func(call *form3.Call, attempt int, resp *http.Response, err error) bool {
+		statuses = append(statuses, resp.StatusCode)
+		return attempt < 2
+	})
+
+	api := form3.New().
+		SetBaseUrl(ts.URL).
+		SetBackOffProvider(testBackOff(10)).
+		SetRetryPolicy(policy).
+		Api()
+
+	err := api.Do(context.Background(), &form3.Call{Method: "POST", Path: "/v1/resource"})
+	assert.ErrorContains(t, err, "HTTP 400: API error message")
+	assert.Equal(t, 3, len(handlerMock.ServeHTTPCalls()))
+	assert.Equal(t, []int{400, 400, 400}, statuses)
+}
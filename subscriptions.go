@@ -0,0 +1,93 @@
+package form3
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// SubscriptionsClient is the Form3 API client for /v1/notification/subscriptions endpoints.
+// Subscriptions register callbacks to be notified of events of the given record type, such as created payments.
+type SubscriptionsClient interface {
+	// Create a new subscription.
+	Create(ctx context.Context, attributes *models.SubscriptionAttributes) (*models.SubscriptionResource, error)
+	// Fetch a single Subscription resource using the resource ID.
+	Fetch(ctx context.Context, id string) (*models.SubscriptionResource, error)
+	// Update a Subscription resource using the resource ID and the current version number. Only non-empty attributes are modified.
+	// Returns VersionConflictError if the version is not the current one.
+	Update(ctx context.Context, id string, version int, attributes *models.SubscriptionAttributes) (*models.SubscriptionResource, error)
+	// Delete a Subscription resource using the resource ID and the current version number.
+	Delete(ctx context.Context, id string, version int) error
+	// List a single page of Subscription resources matching an optional filter.
+	List(ctx context.Context, filter *SubscriptionFilter, opts *ListOptions) (*Page[*models.SubscriptionResource], error)
+	// Iter returns an Iterator over all Subscription resources matching an optional filter, starting from the page given in opts. Pages are fetched lazily.
+	Iter(ctx context.Context, filter *SubscriptionFilter, opts *ListOptions) *Iterator[*models.SubscriptionResource]
+}
+
+// SubscriptionFilter restricts the Subscription resources returned by list endpoints. Empty fields are ignored.
+type SubscriptionFilter struct {
+	RecordType string
+	EventType  string
+}
+
+func (f *SubscriptionFilter) queryParams() (url.Values, error) {
+	if f == nil {
+		return url.Values{}, nil
+	}
+	return filterParams(map[string]string{
+		"record_type": f.RecordType,
+		"event_type":  f.EventType,
+	}), nil
+}
+
+const subscriptionsPath = "/v1/notification/subscriptions"
+
+type subscriptionsClient struct {
+	c *Client
+}
+
+func (s *subscriptionsClient) Create(ctx context.Context, attributes *models.SubscriptionAttributes) (*models.SubscriptionResource, error) {
+	request := &models.SubscriptionResource{
+		Resource:   s.c.newResource("subscriptions"),
+		Attributes: attributes,
+	}
+	return createResource[models.SubscriptionResource](ctx, s.c, subscriptionsPath, request.ID, request)
+}
+
+func (s *subscriptionsClient) Fetch(ctx context.Context, id string) (*models.SubscriptionResource, error) {
+	return fetchResource[models.SubscriptionResource](ctx, s.c, fmt.Sprintf("%s/%s", subscriptionsPath, id))
+}
+
+func (s *subscriptionsClient) Update(ctx context.Context, id string, version int, attributes *models.SubscriptionAttributes) (*models.SubscriptionResource, error) {
+	request := &models.SubscriptionResource{
+		Resource:   s.c.versionedResource("subscriptions", id, version),
+		Attributes: attributes,
+	}
+	return updateResource[models.SubscriptionResource](ctx, s.c, fmt.Sprintf("%s/%s", subscriptionsPath, id), id, version, request)
+}
+
+func (s *subscriptionsClient) Delete(ctx context.Context, id string, version int) error {
+	return deleteResource(ctx, s.c, fmt.Sprintf("%s/%s", subscriptionsPath, id), version)
+}
+
+func (s *subscriptionsClient) List(ctx context.Context, filter *SubscriptionFilter, opts *ListOptions) (*Page[*models.SubscriptionResource], error) {
+	params, err := listParams(filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.list(ctx, params)
+}
+
+func (s *subscriptionsClient) Iter(ctx context.Context, filter *SubscriptionFilter, opts *ListOptions) *Iterator[*models.SubscriptionResource] {
+	params, err := listParams(filter, opts)
+	if err != nil {
+		return newFailedIterator[*models.SubscriptionResource](err)
+	}
+	return newIterator(ctx, params, s.list)
+}
+
+func (s *subscriptionsClient) list(ctx context.Context, params url.Values) (*Page[*models.SubscriptionResource], error) {
+	return listPage[*models.SubscriptionResource](ctx, s.c, subscriptionsPath, params)
+}
@@ -0,0 +1,149 @@
+package form3
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3/models"
+)
+
+func Test_subscriptionsClient_Create(t *testing.T) {
+	apiMock := &ApiMock{
+		DoFunc: func(ctx context.Context, call *Call) error {
+			assert.Equal(t, "POST", call.Method)
+			assert.Equal(t, "/v1/notification/subscriptions", call.Path)
+			assert.True(t, call.Idempotent)
+			require.IsType(t, &models.SubscriptionResource{}, call.Request)
+
+			req := call.Request.(*models.SubscriptionResource)
+			assert.Equal(t, "subscriptions", req.Type)
+			assert.Equal(t, "f2037281-8242-43e6-8536-0614f0b65253", req.ID)
+			assert.Equal(t, "c52fb94b-a795-4c77-969a-74e2364edb28", req.OrganisationId)
+			assert.Equal(t, models.CallbackTransportHTTP, req.Attributes.CallbackTransport)
+			assert.Equal(t, models.RecordTypePayments, req.Attributes.RecordType)
+			return nil
+		},
+	}
+	client := New().
+		SetOrganisationId("c52fb94b-a795-4c77-969a-74e2364edb28").
+		SetUuidProvider(func() string { return "f2037281-8242-43e6-8536-0614f0b65253" })
+	client.api = apiMock
+
+	_, err := client.Subscriptions().Create(context.Background(), &models.SubscriptionAttributes{
+		CallbackTransport: models.CallbackTransportHTTP,
+		CallbackURI:       "https://example.com/form3",
+		EventType:         models.EventTypeCreated,
+		RecordType:        models.RecordTypePayments,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(apiMock.calls.Do))
+}
+
+func Test_subscriptionsClient_Fetch(t *testing.T) {
+	apiMock := &ApiMock{
+		DoFunc: func(ctx context.Context, call *Call) error {
+			assert.Equal(t, "GET", call.Method)
+			assert.Equal(t, "/v1/notification/subscriptions/123", call.Path)
+			assert.IsType(t, &models.SubscriptionResource{}, call.Response)
+			return nil
+		},
+	}
+	client := New()
+	client.api = apiMock
+
+	_, err := client.Subscriptions().Fetch(context.Background(), "123")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(apiMock.calls.Do))
+}
+
+func Test_subscriptionsClient_Update(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				assert.Equal(t, "PATCH", call.Method)
+				assert.Equal(t, "/v1/notification/subscriptions/123", call.Path)
+				require.IsType(t, &models.SubscriptionResource{}, call.Request)
+
+				req := call.Request.(*models.SubscriptionResource)
+				assert.Equal(t, "subscriptions", req.Type)
+				assert.Equal(t, "123", req.ID)
+				require.NotNil(t, req.Version)
+				assert.Equal(t, 1, *req.Version)
+				assert.Equal(t, true, *req.Attributes.Deactivated)
+				return nil
+			},
+		}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Subscriptions().Update(context.Background(), "123", 1, &models.SubscriptionAttributes{Deactivated: Bool(true)})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(apiMock.calls.Do))
+	})
+
+	t.Run("version conflict", func(t *testing.T) {
+		apiMock := &ApiMock{
+			DoFunc: func(ctx context.Context, call *Call) error {
+				return Error{StatusCode: http.StatusConflict, ResponseErrorMessage: "invalid version"}
+			},
+		}
+		client := New()
+		client.api = apiMock
+
+		_, err := client.Subscriptions().Update(context.Background(), "123", 1, &models.SubscriptionAttributes{})
+		var e VersionConflictError
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, "123", e.ID)
+		assert.Equal(t, 1, e.Version)
+	})
+}
+
+func Test_subscriptionsClient_Delete(t *testing.T) {
+	apiMock := &ApiMock{
+		DoFunc: func(ctx context.Context, call *Call) error {
+			assert.Equal(t, "DELETE", call.Method)
+			assert.Equal(t, "/v1/notification/subscriptions/123", call.Path)
+			assert.Equal(t, "3", call.QueryParams.Get("version"))
+			return nil
+		},
+	}
+	client := New()
+	client.api = apiMock
+
+	require.NoError(t, client.Subscriptions().Delete(context.Background(), "123", 3))
+	require.Equal(t, 1, len(apiMock.calls.Do))
+}
+
+func Test_subscriptionsClient_List(t *testing.T) {
+	apiMock := &ApiMock{
+		DoFunc: func(ctx context.Context, call *Call) error {
+			assert.Equal(t, "GET", call.Method)
+			assert.Equal(t, "/v1/notification/subscriptions", call.Path)
+			assert.Equal(t, url.Values{
+				"filter[record_type]": {"payments"},
+				"filter[event_type]":  {"created"},
+				"page[size]":          {"10"},
+			}, call.QueryParams)
+			require.IsType(t, &[]*models.SubscriptionResource{}, call.Response)
+
+			*call.Response.(*[]*models.SubscriptionResource) = []*models.SubscriptionResource{{Resource: models.Resource{ID: "123"}}}
+			return nil
+		},
+	}
+	client := New()
+	client.api = apiMock
+
+	it := client.Subscriptions().Iter(context.Background(), &SubscriptionFilter{
+		RecordType: models.RecordTypePayments,
+		EventType:  models.EventTypeCreated,
+	}, &ListOptions{PageSize: 10})
+	require.True(t, it.Next())
+	assert.Equal(t, "123", it.Value().ID)
+	assert.False(t, it.Next())
+	require.NoError(t, it.Err())
+	require.Equal(t, 1, len(apiMock.calls.Do))
+}
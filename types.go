@@ -9,3 +9,8 @@ func String(v string) *string {
 func Bool(v bool) *bool {
 	return &v
 }
+
+// Int returns a pointer to the given int value.
+func Int(v int) *int {
+	return &v
+}
@@ -0,0 +1,69 @@
+package webhook
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// DefaultDedupSize is the number of notification IDs remembered by the default DedupStore.
+const DefaultDedupSize = 10000
+
+// DedupStore remembers IDs of handled notifications, so that notifications delivered more than once are only handled once.
+// Implementations backed by a shared database allow to deduplicate notifications across multiple instances of the service.
+type DedupStore interface {
+	// Claim atomically records that the notification with the given ID is being handled, and reports whether it had already been claimed.
+	// Of concurrent claims of the same ID, only one must report false.
+	Claim(ctx context.Context, id string) (alreadySeen bool, err error)
+	// Release forgets the claim of the notification with the given ID after its handling failed, so that it is handled again when redelivered.
+	Release(ctx context.Context, id string) error
+}
+
+// LRUStore is an in-memory DedupStore remembering a limited number of the most recently claimed notifications.
+// It is safe for concurrent use.
+type LRUStore struct {
+	size int
+
+	mu    sync.Mutex
+	order *list.List
+	items map[string]*list.Element
+}
+
+// NewLRUStore creates a new LRUStore remembering up to size notification IDs.
+func NewLRUStore(size int) *LRUStore {
+	return &LRUStore{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// Claim implements DedupStore.
+func (s *LRUStore) Claim(_ context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.items[id]; ok {
+		s.order.MoveToFront(e)
+		return true, nil
+	}
+	s.items[id] = s.order.PushFront(id)
+	if s.order.Len() > s.size {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(string))
+	}
+	return false, nil
+}
+
+// Release implements DedupStore.
+func (s *LRUStore) Release(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.items[id]; ok {
+		s.order.Remove(e)
+		delete(s.items, id)
+	}
+	return nil
+}
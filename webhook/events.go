@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+
+	"mkuznets.com/go/form3/models"
+)
+
+// Kind identifies notifications by their record and event types. T is the model type of the record.
+type Kind[T any] struct {
+	RecordType string
+	EventType  string
+}
+
+// Event is a notification with the record decoded into its model type.
+type Event[T any] struct {
+	Notification
+	// Record is the resource the event is about.
+	Record *T
+}
+
+// Kinds of notifications about resources supported by the form3 client.
+var (
+	AccountCreated = Kind[models.AccountResource]{RecordType: models.RecordTypeAccounts, EventType: models.EventTypeCreated}
+	AccountUpdated = Kind[models.AccountResource]{RecordType: models.RecordTypeAccounts, EventType: models.EventTypeUpdated}
+	AccountDeleted = Kind[models.AccountResource]{RecordType: models.RecordTypeAccounts, EventType: models.EventTypeDeleted}
+
+	PaymentCreated           = Kind[models.PaymentResource]{RecordType: models.RecordTypePayments, EventType: models.EventTypeCreated}
+	PaymentUpdated           = Kind[models.PaymentResource]{RecordType: models.RecordTypePayments, EventType: models.EventTypeUpdated}
+	PaymentSubmissionCreated = Kind[models.PaymentSubmissionResource]{RecordType: models.RecordTypePaymentSubmissions, EventType: models.EventTypeCreated}
+	PaymentSubmissionUpdated = Kind[models.PaymentSubmissionResource]{RecordType: models.RecordTypePaymentSubmissions, EventType: models.EventTypeUpdated}
+
+	ReturnCreated           = Kind[models.ReturnResource]{RecordType: models.RecordTypeReturns, EventType: models.EventTypeCreated}
+	ReturnSubmissionUpdated = Kind[models.ReturnSubmissionResource]{RecordType: models.RecordTypeReturnSubmissions, EventType: models.EventTypeUpdated}
+	ReturnAdmissionCreated  = Kind[models.ReturnAdmissionResource]{RecordType: models.RecordTypeReturnAdmissions, EventType: models.EventTypeCreated}
+
+	ReversalCreated           = Kind[models.ReversalResource]{RecordType: models.RecordTypeReversals, EventType: models.EventTypeCreated}
+	ReversalSubmissionUpdated = Kind[models.ReversalSubmissionResource]{RecordType: models.RecordTypeReversalSubmissions, EventType: models.EventTypeUpdated}
+	ReversalAdmissionCreated  = Kind[models.ReversalAdmissionResource]{RecordType: models.RecordTypeReversalAdmissions, EventType: models.EventTypeCreated}
+
+	RecallCreated           = Kind[models.RecallResource]{RecordType: models.RecordTypeRecalls, EventType: models.EventTypeCreated}
+	RecallSubmissionUpdated = Kind[models.RecallSubmissionResource]{RecordType: models.RecordTypeRecallSubmissions, EventType: models.EventTypeUpdated}
+	RecallAdmissionCreated  = Kind[models.RecallAdmissionResource]{RecordType: models.RecordTypeRecallAdmissions, EventType: models.EventTypeCreated}
+
+	DirectDebitCreated           = Kind[models.DirectDebitResource]{RecordType: models.RecordTypeDirectDebits, EventType: models.EventTypeCreated}
+	DirectDebitSubmissionUpdated = Kind[models.DirectDebitSubmissionResource]{RecordType: models.RecordTypeDirectDebitSubmissions, EventType: models.EventTypeUpdated}
+
+	MandateCreated             = Kind[models.MandateResource]{RecordType: models.RecordTypeMandates, EventType: models.EventTypeCreated}
+	MandateSubmissionUpdated   = Kind[models.MandateSubmissionResource]{RecordType: models.RecordTypeMandateSubmissions, EventType: models.EventTypeUpdated}
+	MandateCancellationCreated = Kind[models.MandateCancellationResource]{RecordType: models.RecordTypeMandateCancellations, EventType: models.EventTypeCreated}
+)
+
+// Handle registers the callback for notifications of the given kind, replacing the previous one.
+// A failed callback makes the Handler respond with HTTP 500, so that Form3 delivers the notification again.
+func Handle[T any](h *Handler, kind Kind[T], callback func(ctx context.Context, e *Event[T]) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.callbacks[kindKey{recordType: kind.RecordType, eventType: kind.EventType}] = func(ctx context.Context, n *Notification) error {
+		record := new(T)
+		if err := json.Unmarshal(n.Data, record); err != nil {
+			return &decodeError{n: n, err: err}
+		}
+		return callback(ctx, &Event[T]{Notification: *n, Record: record})
+	}
+}
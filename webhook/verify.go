@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"mkuznets.com/go/form3/internal/httpsig"
+)
+
+// DefaultMaxClockSkew is the maximum difference between the Date header of a notification and the local time accepted by default.
+const DefaultMaxClockSkew = 5 * time.Minute
+
+// KeyFunc returns the public key for the key ID of a notification signature.
+type KeyFunc func(keyID string) (crypto.PublicKey, error)
+
+// PublicKeys returns a KeyFunc looking up public keys (*rsa.PublicKey or *ecdsa.PublicKey) by their key IDs.
+func PublicKeys(keys map[string]crypto.PublicKey) KeyFunc {
+	return func(keyID string) (crypto.PublicKey, error) {
+		key, ok := keys[keyID]
+		if !ok {
+			return nil, fmt.Errorf("unknown key %q", keyID)
+		}
+		return key, nil
+	}
+}
+
+// requiredHeaders must be covered by the signature to authenticate the payload and its freshness.
+var requiredHeaders = []string{"date", "digest"}
+
+// verify checks the signature of the notification, its digest and the freshness of its Date header.
+func (h *Handler) verify(r *http.Request, body []byte) error {
+	signed, err := httpsig.SignedHeaders(r)
+	if err != nil {
+		return err
+	}
+	for _, required := range requiredHeaders {
+		if !contains(signed, required) {
+			return fmt.Errorf("signature does not cover %q", required)
+		}
+	}
+
+	if _, err := httpsig.Verify(r, body, httpsig.KeyFunc(h.keys)); err != nil {
+		return err
+	}
+
+	date, err := http.ParseTime(r.Header.Get("Date"))
+	if err != nil {
+		return fmt.Errorf("invalid Date header: %w", err)
+	}
+	if skew := h.now().Sub(date); skew > h.maxClockSkew || skew < -h.maxClockSkew {
+		return errors.New("stale Date header")
+	}
+	return nil
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+package webhook_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3/internal/httpsig"
+	"mkuznets.com/go/form3/models"
+	"mkuznets.com/go/form3/webhook"
+)
+
+func signedRequest(t *testing.T, keyID string, key crypto.Signer, date time.Time, body string, headers []string) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/form3", strings.NewReader(body))
+	req.Header.Set("Date", date.UTC().Format(http.TimeFormat))
+	req.Header.Set("Digest", httpsig.Digest([]byte(body)))
+	require.NoError(t, httpsig.Sign(req, keyID, key, headers))
+	return req
+}
+
+func serve(h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_Verification(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	newHandler := func(calls *int) *webhook.Handler {
+		h := webhook.NewHandler().SetKeys(webhook.PublicKeys(map[string]crypto.PublicKey{
+			"ec":  ecKey.Public(),
+			"rsa": rsaKey.Public(),
+		}))
+		webhook.Handle(h, webhook.PaymentSubmissionUpdated, func(ctx context.Context, e *webhook.Event[models.PaymentSubmissionResource]) error {
+			*calls++
+			return nil
+		})
+		return h
+	}
+
+	t.Run("valid signatures", func(t *testing.T) {
+		for keyID, key := range map[string]crypto.Signer{"ec": ecKey, "rsa": rsaKey} {
+			calls := 0
+			req := signedRequest(t, keyID, key, time.Now(), submissionUpdated, httpsig.DefaultHeaders)
+			rec := serve(newHandler(&calls), req)
+			assert.Equal(t, http.StatusOK, rec.Code, keyID)
+			assert.Equal(t, 1, calls, keyID)
+		}
+	})
+
+	t.Run("rejected notifications", func(t *testing.T) {
+		tampered := signedRequest(t, "ec", ecKey, time.Now(), submissionUpdated, httpsig.DefaultHeaders)
+		tampered.Body = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(strings.Replace(submissionUpdated, `"version": 1`, `"version": 2`, 1))).Body
+
+		for name, req := range map[string]*http.Request{
+			"unsigned":         httptest.NewRequest(http.MethodPost, "/form3", strings.NewReader(submissionUpdated)),
+			"unknown key":      signedRequest(t, "other", otherKey, time.Now(), submissionUpdated, httpsig.DefaultHeaders),
+			"wrong key":        signedRequest(t, "ec", otherKey, time.Now(), submissionUpdated, httpsig.DefaultHeaders),
+			"tampered body":    tampered,
+			"digest uncovered": signedRequest(t, "ec", ecKey, time.Now(), submissionUpdated, []string{"date"}),
+			"date uncovered":   signedRequest(t, "ec", ecKey, time.Now(), submissionUpdated, []string{"digest"}),
+			"stale date":       signedRequest(t, "ec", ecKey, time.Now().Add(-time.Hour), submissionUpdated, httpsig.DefaultHeaders),
+			"future date":      signedRequest(t, "ec", ecKey, time.Now().Add(time.Hour), submissionUpdated, httpsig.DefaultHeaders),
+		} {
+			calls := 0
+			rec := serve(newHandler(&calls), req)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code, name)
+			assert.Zero(t, calls, name)
+		}
+	})
+
+	t.Run("max clock skew", func(t *testing.T) {
+		calls := 0
+		h := newHandler(&calls).SetMaxClockSkew(2 * time.Hour)
+		rec := serve(h, signedRequest(t, "ec", ecKey, time.Now().Add(-time.Hour), submissionUpdated, httpsig.DefaultHeaders))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+type failingStore struct{}
+
+func (failingStore) Claim(context.Context, string) (bool, error) {
+	return false, errors.New("store is down")
+}
+func (failingStore) Release(context.Context, string) error { return nil }
+
+func TestHandler_Deduplication(t *testing.T) {
+	t.Run("duplicates are acknowledged once handled", func(t *testing.T) {
+		h := webhook.NewHandler()
+		calls := 0
+		fail := true
+		webhook.Handle(h, webhook.PaymentSubmissionUpdated, func(ctx context.Context, e *webhook.Event[models.PaymentSubmissionResource]) error {
+			calls++
+			if fail {
+				return errors.New("database is down")
+			}
+			return nil
+		})
+
+		assert.Equal(t, http.StatusInternalServerError, post(t, h, submissionUpdated).Code)
+		fail = false
+		assert.Equal(t, http.StatusOK, post(t, h, submissionUpdated).Code)
+		assert.Equal(t, http.StatusOK, post(t, h, submissionUpdated).Code)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("concurrent duplicates are handled once", func(t *testing.T) {
+		h := webhook.NewHandler()
+		var calls int32
+		webhook.Handle(h, webhook.PaymentSubmissionUpdated, func(ctx context.Context, e *webhook.Event[models.PaymentSubmissionResource]) error {
+			atomic.AddInt32(&calls, 1)
+			time.Sleep(50 * time.Millisecond)
+			return nil
+		})
+
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				assert.Equal(t, http.StatusOK, post(t, h, submissionUpdated).Code)
+			}()
+		}
+		close(start)
+		wg.Wait()
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		h := webhook.NewHandler().SetDedupStore(nil)
+		calls := 0
+		webhook.Handle(h, webhook.PaymentSubmissionUpdated, func(ctx context.Context, e *webhook.Event[models.PaymentSubmissionResource]) error {
+			calls++
+			return nil
+		})
+
+		post(t, h, submissionUpdated)
+		post(t, h, submissionUpdated)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("store failure", func(t *testing.T) {
+		h := webhook.NewHandler().SetDedupStore(failingStore{})
+		rec := post(t, h, submissionUpdated)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "store is down")
+	})
+}
+
+func TestLRUStore(t *testing.T) {
+	ctx := context.Background()
+	s := webhook.NewLRUStore(2)
+
+	claim := func(id string) bool {
+		seen, err := s.Claim(ctx, id)
+		require.NoError(t, err)
+		return seen
+	}
+
+	assert.False(t, claim("a"))
+	assert.False(t, claim("b"))
+	// Claiming "a" again makes "b" the least recently used, which is evicted by "c".
+	assert.True(t, claim("a"))
+	assert.False(t, claim("c"))
+	assert.False(t, claim("b"))
+
+	require.NoError(t, s.Release(ctx, "c"))
+	assert.False(t, claim("c"))
+}
@@ -0,0 +1,168 @@
+// Package webhook receives Form3 notifications delivered to subscriptions with the http callback transport.
+//
+//	h := webhook.NewHandler()
+//	webhook.Handle(h, webhook.PaymentSubmissionUpdated, func(ctx context.Context, e *webhook.Event[models.PaymentSubmissionResource]) error {
+//		log.Printf("payment submission %s is %s", e.Record.ID, e.Record.Attributes.Status)
+//		return nil
+//	})
+//	http.Handle("/form3", h)
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// MaxBodySize is the maximum size of a notification payload accepted by Handler.
+const MaxBodySize = 1 << 20
+
+// Notification is the envelope of a Form3 notification. Data is the JSON of the record the event is about.
+type Notification struct {
+	ID             string          `json:"id"`
+	OrganisationID string          `json:"organisation_id"`
+	Version        int             `json:"version"`
+	EventType      string          `json:"event_type"`
+	RecordType     string          `json:"record_type"`
+	Data           json.RawMessage `json:"data"`
+}
+
+// Handler is an http.Handler decoding Form3 notifications and dispatching them to the callbacks registered with Handle.
+// If keys are configured with SetKeys, notifications must be signed, and their Date header must be fresh.
+// Notifications that have already been handled, or are being handled concurrently, are acknowledged without calling the callback again.
+//
+// It responds with:
+//   - HTTP 401 to notifications failing the verification;
+//   - HTTP 400 to malformed notifications, which are not worth retrying;
+//   - HTTP 500 if the callback fails, so that Form3 delivers the notification again later;
+//   - HTTP 200 otherwise, including notifications without a registered callback.
+type Handler struct {
+	keys         KeyFunc
+	maxClockSkew time.Duration
+	dedup        DedupStore
+	now          func() time.Time
+
+	mu        sync.RWMutex
+	callbacks map[kindKey]func(ctx context.Context, n *Notification) error
+}
+
+type kindKey struct {
+	recordType string
+	eventType  string
+}
+
+// NewHandler creates a new Handler without callbacks. Notifications are deduplicated with an LRUStore of DefaultDedupSize.
+func NewHandler() *Handler {
+	return &Handler{
+		maxClockSkew: DefaultMaxClockSkew,
+		dedup:        NewLRUStore(DefaultDedupSize),
+		now:          time.Now,
+		callbacks:    make(map[kindKey]func(ctx context.Context, n *Notification) error),
+	}
+}
+
+// SetKeys enables verification of notification signatures with the public keys returned by keys. Nil disables it.
+func (h *Handler) SetKeys(keys KeyFunc) *Handler {
+	h.keys = keys
+	return h
+}
+
+// SetMaxClockSkew configures the maximum difference between the Date header of a notification and the local time.
+func (h *Handler) SetMaxClockSkew(v time.Duration) *Handler {
+	h.maxClockSkew = v
+	return h
+}
+
+// SetDedupStore configures the DedupStore of handled notifications. Nil disables deduplication.
+func (h *Handler) SetDedupStore(v DedupStore) *Handler {
+	h.dedup = v
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodySize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("reading notification: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if h.keys != nil {
+		if err := h.verify(r, body); err != nil {
+			http.Error(w, fmt.Sprintf("unauthenticated notification: %v", err), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	n := &Notification{}
+	if err := json.Unmarshal(body, n); err != nil {
+		http.Error(w, fmt.Sprintf("invalid notification: %v", err), http.StatusBadRequest)
+		return
+	}
+	if n.RecordType == "" || n.EventType == "" {
+		http.Error(w, "invalid notification: record_type and event_type are required", http.StatusBadRequest)
+		return
+	}
+
+	// The notification is claimed before dispatching, so that concurrent redeliveries of it are not handled twice.
+	// Notifications that fail are released, so that they are handled again when redelivered.
+	dedup := h.dedup != nil && n.ID != ""
+	if dedup {
+		seen, err := h.dedup.Claim(r.Context(), n.ID)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("deduplicating notification %s: %v", n.ID, err), http.StatusInternalServerError)
+			return
+		}
+		if seen {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	if err := h.dispatch(r.Context(), n); err != nil {
+		if dedup {
+			if releaseErr := h.dedup.Release(r.Context(), n.ID); releaseErr != nil {
+				err = fmt.Errorf("%w (releasing the notification: %v)", err, releaseErr)
+			}
+		}
+		var decodeErr *decodeError
+		if errors.As(err, &decodeErr) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("handling notification %s: %v", n.ID, err), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) dispatch(ctx context.Context, n *Notification) error {
+	h.mu.RLock()
+	callback, ok := h.callbacks[kindKey{recordType: n.RecordType, eventType: n.EventType}]
+	h.mu.RUnlock()
+
+	if !ok {
+		return nil
+	}
+	return callback(ctx, n)
+}
+
+// decodeError is returned when the record of a notification cannot be decoded into the model type of its Kind.
+type decodeError struct {
+	n   *Notification
+	err error
+}
+
+func (e *decodeError) Error() string {
+	return fmt.Sprintf("invalid %s %s notification %s: %v", e.n.RecordType, e.n.EventType, e.n.ID, e.err)
+}
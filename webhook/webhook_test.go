@@ -0,0 +1,99 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"mkuznets.com/go/form3/models"
+	"mkuznets.com/go/form3/webhook"
+)
+
+const submissionUpdated = `{
+	"id": "a3a2b0b6-3e0c-4c1e-8d35-5d2d2d4a3c11",
+	"organisation_id": "eb0bd6f5-c3f5-44b2-b677-acd23cdde73c",
+	"version": 1,
+	"event_type": "updated",
+	"record_type": "payment_submissions",
+	"data": {
+		"id": "9b1a4f7e-6b8c-4d5e-9f0a-1b2c3d4e5f60",
+		"type": "payment_submissions",
+		"attributes": {"status": "delivery_confirmed"}
+	}
+}`
+
+func post(t *testing.T, h http.Handler, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/form3", strings.NewReader(body)))
+	return rec
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("dispatches typed event", func(t *testing.T) {
+		h := webhook.NewHandler()
+
+		var got *webhook.Event[models.PaymentSubmissionResource]
+		webhook.Handle(h, webhook.PaymentSubmissionUpdated, func(ctx context.Context, e *webhook.Event[models.PaymentSubmissionResource]) error {
+			got = e
+			return nil
+		})
+		webhook.Handle(h, webhook.AccountCreated, func(ctx context.Context, e *webhook.Event[models.AccountResource]) error {
+			t.Error("unexpected account event")
+			return nil
+		})
+
+		rec := post(t, h, submissionUpdated)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.NotNil(t, got)
+		assert.Equal(t, "a3a2b0b6-3e0c-4c1e-8d35-5d2d2d4a3c11", got.ID)
+		assert.Equal(t, 1, got.Version)
+		assert.Equal(t, models.EventTypeUpdated, got.EventType)
+		assert.Equal(t, "9b1a4f7e-6b8c-4d5e-9f0a-1b2c3d4e5f60", got.Record.ID)
+		assert.Equal(t, models.SubmissionStatusDeliveryConfirmed, got.Record.Attributes.Status)
+	})
+
+	t.Run("unhandled kind is acknowledged", func(t *testing.T) {
+		rec := post(t, webhook.NewHandler(), submissionUpdated)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("callback failure", func(t *testing.T) {
+		h := webhook.NewHandler()
+		webhook.Handle(h, webhook.PaymentSubmissionUpdated, func(ctx context.Context, e *webhook.Event[models.PaymentSubmissionResource]) error {
+			return errors.New("database is down")
+		})
+
+		rec := post(t, h, submissionUpdated)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "database is down")
+	})
+
+	t.Run("malformed notifications", func(t *testing.T) {
+		h := webhook.NewHandler()
+		webhook.Handle(h, webhook.AccountCreated, func(ctx context.Context, e *webhook.Event[models.AccountResource]) error {
+			return nil
+		})
+
+		for name, body := range map[string]string{
+			"invalid JSON":        `{"id":`,
+			"missing record type": `{"id": "1", "event_type": "created", "data": {}}`,
+			"invalid record":      `{"id": "1", "event_type": "created", "record_type": "accounts", "data": {"attributes": []}}`,
+			"too large":           `{"id": "` + strings.Repeat("a", webhook.MaxBodySize) + `"}`,
+		} {
+			rec := post(t, h, body)
+			assert.Equal(t, http.StatusBadRequest, rec.Code, name)
+		}
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		webhook.NewHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/form3", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}